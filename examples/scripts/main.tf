@@ -36,8 +36,9 @@ resource "landscape_script_profile" "on_enroll" {
   username   = "root"
   time_limit = 300
   trigger = {
-    type       = "event"
-    event_type = "post_enrollment"
+    event = {
+      event_type = "post_enrollment"
+    }
   }
 }
 
@@ -48,9 +49,10 @@ resource "landscape_script_profile" "hourly" {
   time_limit = 60
   tags       = ["web", "prod"]
   trigger = {
-    type        = "recurring"
-    interval    = "0 * * * *"
-    start_after = "2026-04-01T00:00:00Z"
+    recurring = {
+      interval    = "0 * * * *"
+      start_after = "2026-04-01T00:00:00Z"
+    }
   }
 }
 
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+// legacyAccessGroup is an access group as returned by the legacy
+// GetAccessGroups and CreateAccessGroup actions.
+type legacyAccessGroup struct {
+	Name   string  `json:"name"`
+	Title  string  `json:"title"`
+	Parent *string `json:"parent"`
+}
+
+// fetchAccessGroup looks up an access group by name. found is false when no
+// group has that name.
+func fetchAccessGroup(ctx context.Context, client *landscape.ClientWithResponses, name string) (legacyAccessGroup, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := []string{name}
+	rawResp, err := client.LegacyGetAccessGroups(ctx, &landscape.LegacyGetAccessGroupsParams{
+		Names: &names,
+	})
+	if err != nil {
+		diags.AddError("Failed to read access group", err.Error())
+		return legacyAccessGroup{}, false, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read access group", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return legacyAccessGroup{}, false, diags
+	}
+
+	groups, err := landscape.ParseLegacyResponse[[]legacyAccessGroup](body)
+	if err != nil {
+		diags.AddError("Failed to parse access group response", err.Error())
+		return legacyAccessGroup{}, false, diags
+	}
+	for _, g := range groups {
+		if g.Name == name {
+			return g, true, diags
+		}
+	}
+	return legacyAccessGroup{}, false, diags
+}
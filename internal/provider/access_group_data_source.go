@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &AccessGroupDataSource{}
+var _ datasource.DataSourceWithConfigure = &AccessGroupDataSource{}
+
+func NewAccessGroupDataSource() datasource.DataSource {
+	return &AccessGroupDataSource{}
+}
+
+type AccessGroupDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type AccessGroupDataSourceModel struct {
+	Name   types.String `tfsdk:"name"`
+	Title  types.String `tfsdk:"title"`
+	Parent types.String `tfsdk:"parent"`
+}
+
+func (d *AccessGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_group"
+}
+
+func (d *AccessGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a Landscape access group by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The access group name, e.g. `global`.",
+			},
+			"title": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Display title of the access group.",
+			},
+			"parent": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the parent access group. Null for the root `global` group.",
+			},
+		},
+	}
+}
+
+func (d *AccessGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *AccessGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AccessGroupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, found, diags := fetchAccessGroup(ctx, d.client, config.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Access group not found", fmt.Sprintf("No access group is named %q", config.Name.ValueString()))
+		return
+	}
+
+	state := AccessGroupDataSourceModel{
+		Name:   types.StringValue(group.Name),
+		Title:  types.StringValue(group.Title),
+		Parent: types.StringPointerValue(group.Parent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
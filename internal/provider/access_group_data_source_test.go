@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestAccessGroupDataSourceMetadata(t *testing.T) {
+	dataSource := NewAccessGroupDataSource()
+
+	var resp datasource.MetadataResponse
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_access_group" {
+		t.Fatalf("expected data source type name landscape_access_group, got %q", resp.TypeName)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &AccessGroupResource{}
+var _ resource.ResourceWithImportState = &AccessGroupResource{}
+var _ resource.ResourceWithIdentity = &AccessGroupResource{}
+
+func NewAccessGroupResource() resource.Resource {
+	return &AccessGroupResource{}
+}
+
+type AccessGroupResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type AccessGroupResourceModel struct {
+	Name   types.String `tfsdk:"name"`
+	Title  types.String `tfsdk:"title"`
+	Parent types.String `tfsdk:"parent"`
+}
+
+func (r *AccessGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_group"
+}
+
+func (r *AccessGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Manages a Landscape access group. Reference its `name` from the `access_group` attribute of other resources so the group is created first. " +
+			"Removing the group moves its computers and objects to the parent group.",
+		Attributes: map[string]resourceschema.Attribute{
+			"name": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The access group name, derived by Landscape from the title.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Display title of the access group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent": resourceschema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("global"),
+				MarkdownDescription: "Name of the parent access group. Defaults to `global`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccessGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Name of the access group.")
+}
+
+func (r *AccessGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *AccessGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccessGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API identifies the parent by title, while everything else in the
+	// provider refers to access groups by name.
+	parent, found, diags := fetchAccessGroup(ctx, r.client, plan.Parent.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(path.Root("parent"), "Parent access group not found",
+			fmt.Sprintf("No access group is named %q", plan.Parent.ValueString()))
+		return
+	}
+
+	rawResp, err := r.client.LegacyCreateAccessGroup(ctx, &landscape.LegacyCreateAccessGroupParams{
+		Title:  plan.Title.ValueString(),
+		Parent: &parent.Title,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create access group", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Failed to create access group", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return
+	}
+
+	group, err := landscape.ParseLegacyResponse[legacyAccessGroup](body)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse access group response", err.Error())
+		return
+	}
+	if group.Name == "" {
+		resp.Diagnostics.AddError("Failed to parse access group response", "response missing 'name' field")
+		return
+	}
+	plan.Name = types.StringValue(group.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *AccessGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccessGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, found, diags := fetchAccessGroup(ctx, r.client, state.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Title = types.StringValue(group.Title)
+	if group.Parent != nil {
+		state.Parent = types.StringValue(*group.Parent)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
+}
+
+func (r *AccessGroupResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// All fields require replacement; Update is never called.
+}
+
+func (r *AccessGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccessGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := r.client.LegacyRemoveAccessGroup(ctx, &landscape.LegacyRemoveAccessGroupParams{
+		Name: state.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to remove access group", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	if rawResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(rawResp.Body)
+		resp.Diagnostics.AddError("Failed to remove access group", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+	}
+}
+
+func (r *AccessGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccessGroupResourceMetadata(t *testing.T) {
+	res := NewAccessGroupResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_access_group" {
+		t.Fatalf("expected resource type name landscape_access_group, got %q", resp.TypeName)
+	}
+}
+
+func TestAccAccessGroupResourceMissingTitle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccessGroupResourceMissingTitleConfig,
+				ExpectError: regexp.MustCompile(`(?i)title`),
+			},
+		},
+	})
+}
+
+const testAccAccessGroupResourceMissingTitleConfig = `
+provider "landscape" {}
+
+resource "landscape_access_group" "test" {
+  parent = "global"
+}
+`
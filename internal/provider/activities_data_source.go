@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &ActivitiesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ActivitiesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ActivitiesDataSource{}
+
+func NewActivitiesDataSource() datasource.DataSource {
+	return &ActivitiesDataSource{}
+}
+
+type ActivitiesDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ActivitiesDataSourceModel struct {
+	ScriptProfileId types.Int64  `tfsdk:"script_profile_id"`
+	ScriptId        types.Int64  `tfsdk:"script_id"`
+	ComputerId      types.Int64  `tfsdk:"computer_id"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAfter    types.String `tfsdk:"created_after"`
+	CreatedBefore   types.String `tfsdk:"created_before"`
+	Query           types.String `tfsdk:"query"`
+	MaxRuns         types.Int64  `tfsdk:"max_runs"`
+	Ids             types.List   `tfsdk:"ids"`
+	Activities      types.List   `tfsdk:"activities"`
+}
+
+var activityAttrTypes = map[string]attr.Type{
+	"id":           types.Int64Type,
+	"parent_id":    types.Int64Type,
+	"computer_id":  types.Int64Type,
+	"type":         types.StringType,
+	"status":       types.StringType,
+	"summary":      types.StringType,
+	"result_code":  types.Int64Type,
+	"output":       types.StringType,
+	"created_at":   types.StringType,
+	"completed_at": types.StringType,
+}
+
+// defaultActivityMaxRuns is how many of each profile's most recent runs are
+// read when max_runs is not set.
+const defaultActivityMaxRuns = 10
+
+// activityFilter holds the conditions on activities. They are passed to the
+// activity search as far as it supports them and applied exactly after the
+// activities are fetched. Zero values match everything.
+type activityFilter struct {
+	computerID    int64
+	status        string
+	createdAfter  time.Time
+	createdBefore time.Time
+}
+
+func (d *ActivitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activities"
+}
+
+func (d *ActivitiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Landscape activities, e.g. to audit what script profile runs did on each computer. Filters are combined. " +
+			"With `script_profile_id` or `script_id` only the per-computer activities of the profile runs are returned. " +
+			"Otherwise at least one of `status`, `created_after`, `created_before` or `query` is required, so the whole activity history is not read.",
+		Attributes: map[string]schema.Attribute{
+			"script_profile_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return activities started by this script profile.",
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("script_id")),
+				},
+			},
+			"script_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return activities started by the script profiles that run this script.",
+			},
+			"computer_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return activities on this computer. Applied after the activities are read, so it does not narrow the search on its own.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return activities with this status, e.g. `succeeded`, `failed`, `canceled` or `delivered`.",
+			},
+			"created_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "RFC3339 datetime; only return activities created at or after it.",
+			},
+			"created_before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "RFC3339 datetime; only return activities created before it.",
+			},
+			"query": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Additional Landscape activity search query passed to the API as-is.",
+			},
+			"max_runs": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("With `script_profile_id` or `script_id`, only read the activities of each profile's most recent runs, "+
+					"one activity query per run. Defaults to `%d`.", defaultActivityMaxRuns),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the matching activities, in ascending order.",
+			},
+			"activities": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching activities, ordered by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The activity ID.",
+						},
+						"parent_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The activity that created this one, e.g. a script profile run.",
+						},
+						"computer_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The computer the activity ran on.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The activity type, e.g. `ExecuteScriptRequest`.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The activity status.",
+						},
+						"summary": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Short description of the activity.",
+						},
+						"result_code": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Result code, e.g. a script's exit code.",
+						},
+						"output": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Result text, e.g. a script's combined stdout and stderr.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the activity was created.",
+						},
+						"completed_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the activity finished.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ActivitiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ActivitiesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config ActivitiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := config.filter()
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *ActivitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ActivitiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := config.filter()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries, diags := d.queries(ctx, config, filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[int]bool{}
+	var activities []legacyActivity
+	for _, query := range queries {
+		page, diags := fetchActivities(ctx, d.client, query)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, a := range page {
+			if !seen[a.Id] && filter.matches(a) {
+				seen[a.Id] = true
+				activities = append(activities, a)
+			}
+		}
+	}
+	sort.Slice(activities, func(i, j int) bool { return activities[i].Id < activities[j].Id })
+
+	ids := make([]attr.Value, 0, len(activities))
+	elems := make([]attr.Value, 0, len(activities))
+	for _, a := range activities {
+		obj, diags := types.ObjectValue(activityAttrTypes, activityAttrValues(a))
+		resp.Diagnostics.Append(diags...)
+		ids = append(ids, types.Int64Value(int64(a.Id)))
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idList, diags := types.ListValue(types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	activityList, diags := types.ListValue(types.ObjectType{AttrTypes: activityAttrTypes}, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Ids = idList
+	config.Activities = activityList
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// queries returns the activity search queries to run. Profile filters expand
+// to one parent-id query per recent profile run, newest first.
+func (d *ActivitiesDataSource) queries(ctx context.Context, config ActivitiesDataSourceModel, filter activityFilter) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	extra := strings.TrimSpace(filter.searchQuery() + " " + config.Query.ValueString())
+
+	var profileIDs []int
+	switch {
+	case !config.ScriptProfileId.IsNull():
+		profileIDs = []int{int(config.ScriptProfileId.ValueInt64())}
+	case !config.ScriptId.IsNull():
+		ids, scriptDiags := scriptProfileIDsForScript(ctx, d.client, int(config.ScriptId.ValueInt64()))
+		diags.Append(scriptDiags...)
+		profileIDs = ids
+	default:
+		return []string{extra}, diags
+	}
+
+	maxRuns := defaultActivityMaxRuns
+	if !config.MaxRuns.IsNull() {
+		maxRuns = int(config.MaxRuns.ValueInt64())
+	}
+
+	var queries []string
+	for _, profileID := range profileIDs {
+		runIDs, runDiags := scriptProfileRunIDs(ctx, d.client, profileID)
+		diags.Append(runDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for _, runID := range recentRunIDs(runIDs, maxRuns) {
+			queries = append(queries, strings.TrimSpace(fmt.Sprintf("parent-id:%d %s", runID, extra)))
+		}
+	}
+	return queries, diags
+}
+
+// recentRunIDs returns the n highest run IDs, newest first. Activity IDs
+// increase over time.
+func recentRunIDs(ids []int, n int) []int {
+	sorted := make([]int, len(ids))
+	copy(sorted, ids)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+func (m ActivitiesDataSourceModel) filter() (activityFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	f := activityFilter{
+		computerID: m.ComputerId.ValueInt64(),
+		status:     m.Status.ValueString(),
+	}
+	if !m.CreatedAfter.IsNull() && !m.CreatedAfter.IsUnknown() {
+		ts, tsDiags := parseScheduleTimestamp("created_after", m.CreatedAfter.ValueString())
+		diags.Append(tsDiags...)
+		f.createdAfter = ts
+	}
+	if !m.CreatedBefore.IsNull() && !m.CreatedBefore.IsUnknown() {
+		ts, tsDiags := parseScheduleTimestamp("created_before", m.CreatedBefore.ValueString())
+		diags.Append(tsDiags...)
+		f.createdBefore = ts
+	}
+	if !m.narrowed() {
+		diags.AddError(
+			"Missing activity filter",
+			"Set script_profile_id, script_id, status, created_after, created_before or query. Without one of them every activity in the account would be read.",
+		)
+	}
+	return f, diags
+}
+
+// narrowed reports whether the configuration limits the activity search
+// itself. computer_id is only applied client-side and does not count.
+func (m ActivitiesDataSourceModel) narrowed() bool {
+	if !m.ScriptProfileId.IsNull() || !m.ScriptId.IsNull() {
+		return true
+	}
+	for _, v := range []types.String{m.Status, m.CreatedAfter, m.CreatedBefore, m.Query} {
+		if v.IsUnknown() || v.ValueString() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// searchQuery returns the Landscape activity search tokens for the filter.
+// The computer is not part of the search and is only checked by matches.
+// The search only takes dates, so the time window is widened to whole days.
+func (f activityFilter) searchQuery() string {
+	var tokens []string
+	if f.status != "" {
+		tokens = append(tokens, "status:"+f.status)
+	}
+	if !f.createdAfter.IsZero() {
+		tokens = append(tokens, "created-after:"+f.createdAfter.UTC().Format(time.DateOnly))
+	}
+	if !f.createdBefore.IsZero() {
+		tokens = append(tokens, "created-before:"+f.createdBefore.UTC().AddDate(0, 0, 1).Format(time.DateOnly))
+	}
+	return strings.Join(tokens, " ")
+}
+
+func (f activityFilter) matches(a legacyActivity) bool {
+	if f.computerID != 0 && computerIDOf(a) != f.computerID {
+		return false
+	}
+	if f.status != "" && a.ActivityStatus != f.status {
+		return false
+	}
+	if f.createdAfter.IsZero() && f.createdBefore.IsZero() {
+		return true
+	}
+
+	created, ok := parseActivityTime(a.CreationTime)
+	if !ok {
+		return false
+	}
+	if !f.createdAfter.IsZero() && created.Before(f.createdAfter) {
+		return false
+	}
+	if !f.createdBefore.IsZero() && !created.Before(f.createdBefore) {
+		return false
+	}
+	return true
+}
+
+// parseActivityTime parses an activity timestamp. The legacy API omits the
+// zone designator on some versions; those times are UTC.
+func parseActivityTime(s *string) (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.Parse(layout, *s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func activityAttrValues(a legacyActivity) map[string]attr.Value {
+	optionalInt := func(v *int) types.Int64 {
+		if v == nil {
+			return types.Int64Null()
+		}
+		return types.Int64Value(int64(*v))
+	}
+	return map[string]attr.Value{
+		"id":           types.Int64Value(int64(a.Id)),
+		"parent_id":    optionalInt(a.ParentId),
+		"computer_id":  optionalInt(a.ComputerId),
+		"type":         types.StringValue(a.Type),
+		"status":       types.StringValue(a.ActivityStatus),
+		"summary":      types.StringValue(a.Summary),
+		"result_code":  optionalInt(a.ResultCode),
+		"output":       types.StringPointerValue(a.ResultText),
+		"created_at":   types.StringPointerValue(a.CreationTime),
+		"completed_at": types.StringPointerValue(a.CompletionTime),
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestActivitiesDataSourceMetadata(t *testing.T) {
+	dataSource := NewActivitiesDataSource()
+
+	var resp datasource.MetadataResponse
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_activities" {
+		t.Fatalf("expected data source type name landscape_activities, got %q", resp.TypeName)
+	}
+}
+
+func TestActivityFilterMatches(t *testing.T) {
+	computer := 4
+	created := "2026-03-01T12:00:00"
+	a := legacyActivity{ComputerId: &computer, ActivityStatus: activityStatusFailed, CreationTime: &created}
+
+	cases := []struct {
+		name   string
+		filter activityFilter
+		want   bool
+	}{
+		{"empty", activityFilter{}, true},
+		{"computer", activityFilter{computerID: 4}, true},
+		{"other computer", activityFilter{computerID: 5}, false},
+		{"status", activityFilter{status: activityStatusSucceeded}, false},
+		{"inside window", activityFilter{
+			createdAfter:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			createdBefore: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		}, true},
+		{"before window", activityFilter{createdAfter: time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC)}, false},
+		{"end is exclusive", activityFilter{createdBefore: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.filter.matches(a); got != tc.want {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestActivityFilterSearchQuery(t *testing.T) {
+	cases := []struct {
+		name   string
+		filter activityFilter
+		want   string
+	}{
+		{"empty", activityFilter{}, ""},
+		{"computer filtered client-side", activityFilter{computerID: 4, status: activityStatusFailed}, "status:" + activityStatusFailed},
+		{"window widened to days", activityFilter{
+			createdAfter:  time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC),
+			createdBefore: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+		}, "created-after:2026-03-01 created-before:2026-03-03"},
+	}
+	for _, tc := range cases {
+		if got := tc.filter.searchQuery(); got != tc.want {
+			t.Errorf("%s: expected %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestActivitiesDataSourceModelNarrowed(t *testing.T) {
+	base := ActivitiesDataSourceModel{
+		ScriptProfileId: types.Int64Null(),
+		ScriptId:        types.Int64Null(),
+		ComputerId:      types.Int64Null(),
+		Status:          types.StringNull(),
+		CreatedAfter:    types.StringNull(),
+		CreatedBefore:   types.StringNull(),
+		Query:           types.StringNull(),
+	}
+	cases := []struct {
+		name string
+		edit func(*ActivitiesDataSourceModel)
+		want bool
+	}{
+		{"nothing set", func(*ActivitiesDataSourceModel) {}, false},
+		{"computer only", func(m *ActivitiesDataSourceModel) { m.ComputerId = types.Int64Value(4) }, false},
+		{"empty query", func(m *ActivitiesDataSourceModel) { m.Query = types.StringValue("") }, false},
+		{"script profile", func(m *ActivitiesDataSourceModel) { m.ScriptProfileId = types.Int64Value(1) }, true},
+		{"status", func(m *ActivitiesDataSourceModel) { m.Status = types.StringValue(activityStatusFailed) }, true},
+		{"unknown window", func(m *ActivitiesDataSourceModel) { m.CreatedAfter = types.StringUnknown() }, true},
+	}
+	for _, tc := range cases {
+		m := base
+		tc.edit(&m)
+		if got := m.narrowed(); got != tc.want {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestRecentRunIDs(t *testing.T) {
+	ids := []int{3, 9, 1, 7}
+	got := recentRunIDs(ids, 2)
+	if !reflect.DeepEqual(got, []int{9, 7}) {
+		t.Errorf("expected [9 7], got %v", got)
+	}
+	if got := recentRunIDs(ids, 10); !reflect.DeepEqual(got, []int{9, 7, 3, 1}) {
+		t.Errorf("expected all runs newest first, got %v", got)
+	}
+	if ids[0] != 3 {
+		t.Errorf("input was modified: %v", ids)
+	}
+}
+
+func TestAccActivitiesDataSourceInvalidWindow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccActivitiesDataSourceInvalidWindowConfig,
+				ExpectError: regexp.MustCompile(`(?i)created_after`),
+			},
+		},
+	})
+}
+
+const testAccActivitiesDataSourceInvalidWindowConfig = `
+provider "landscape" {}
+
+data "landscape_activities" "test" {
+  created_after = "yesterday"
+}
+`
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+// Activity statuses reported by the legacy API.
+const (
+	activityStatusSucceeded = "succeeded"
+	activityStatusFailed    = "failed"
+	activityStatusCanceled  = "canceled"
+)
+
+const activityPageSize = 1000
+
+// legacyActivity is an activity as returned by the legacy GetActivities and
+// ExecuteScript actions. Per-computer activities point at the activity that
+// created them through ParentId.
+type legacyActivity struct {
+	Id             int     `json:"id"`
+	ParentId       *int    `json:"parent_id"`
+	ComputerId     *int    `json:"computer_id"`
+	Type           string  `json:"type"`
+	Summary        string  `json:"summary"`
+	ActivityStatus string  `json:"activity_status"`
+	ResultCode     *int    `json:"result_code"`
+	ResultText     *string `json:"result_text"`
+	CreationTime   *string `json:"creation_time"`
+	CompletionTime *string `json:"completion_time"`
+}
+
+// finished reports whether the activity has reached a final status.
+func (a legacyActivity) finished() bool {
+	switch a.ActivityStatus {
+	case activityStatusSucceeded, activityStatusFailed, activityStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// fetchActivities returns every activity matching a Landscape activity query,
+// following the legacy API's limit/offset pagination.
+func fetchActivities(ctx context.Context, client *landscape.ClientWithResponses, query string) ([]legacyActivity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var activities []legacyActivity
+
+	limit := activityPageSize
+	for offset := 0; ; offset += limit {
+		params := &landscape.LegacyGetActivitiesParams{
+			Limit:  &limit,
+			Offset: &offset,
+		}
+		if query != "" {
+			params.Query = &query
+		}
+
+		rawResp, err := client.LegacyGetActivities(ctx, params)
+		if err != nil {
+			diags.AddError("Failed to read activities", err.Error())
+			return nil, diags
+		}
+		body, _ := io.ReadAll(rawResp.Body)
+		rawResp.Body.Close()
+		if rawResp.StatusCode != http.StatusOK {
+			diags.AddError("Failed to read activities", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+			return nil, diags
+		}
+
+		page, err := landscape.ParseLegacyResponse[[]legacyActivity](body)
+		if err != nil {
+			diags.AddError("Failed to parse activities response", err.Error())
+			return nil, diags
+		}
+		activities = append(activities, page...)
+		if len(page) < limit {
+			return activities, diags
+		}
+	}
+}
+
+// scriptProfileRunIDs returns the IDs of the activities a script profile has
+// started, one per run.
+func scriptProfileRunIDs(ctx context.Context, client *landscape.ClientWithResponses, profileID int) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.ListScriptProfileActivitiesWithResponse(ctx, profileID)
+	if err != nil {
+		diags.AddError("Failed to read script profile activities", err.Error())
+		return nil, diags
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Failed to read script profile activities", fmt.Sprintf("status %s: %s", res.Status(), res.Body))
+		return nil, diags
+	}
+
+	ids := make([]int, 0, len(res.JSON200.Results))
+	for _, run := range res.JSON200.Results {
+		if id, ok := run["id"].(float64); ok {
+			ids = append(ids, int(id))
+		}
+	}
+	return ids, diags
+}
+
+// scriptProfileIDsForScript returns the IDs of the script profiles that run a
+// script.
+func scriptProfileIDsForScript(ctx context.Context, client *landscape.ClientWithResponses, scriptID int) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.ListScriptProfilesByScriptWithResponse(ctx, scriptID)
+	if err != nil {
+		diags.AddError("Failed to read script profiles", err.Error())
+		return nil, diags
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Failed to read script profiles", fmt.Sprintf("status %s: %s", res.Status(), res.Body))
+		return nil, diags
+	}
+
+	ids := make([]int, 0, len(*res.JSON200))
+	for _, p := range *res.JSON200 {
+		ids = append(ids, p.Id)
+	}
+	return ids, diags
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+const (
+	administratorStatusInvited = "invited"
+	administratorStatusActive  = "active"
+)
+
+// legacyAdministrator is an administrator as returned by the legacy
+// GetAdministrators action. Pending invitations are not included.
+type legacyAdministrator struct {
+	Id    int64    `json:"id"`
+	Name  string   `json:"name"`
+	Email string   `json:"email"`
+	Roles []string `json:"roles"`
+}
+
+// fetchAdministrators returns every administrator of the account.
+func fetchAdministrators(ctx context.Context, client *landscape.ClientWithResponses) ([]legacyAdministrator, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawResp, err := client.LegacyGetAdministrators(ctx)
+	if err != nil {
+		diags.AddError("Failed to read administrators", err.Error())
+		return nil, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read administrators", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return nil, diags
+	}
+
+	administrators, err := landscape.ParseLegacyResponse[[]legacyAdministrator](body)
+	if err != nil {
+		diags.AddError("Failed to parse administrators response", err.Error())
+		return nil, diags
+	}
+	return administrators, diags
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &AdministratorResource{}
+var _ resource.ResourceWithImportState = &AdministratorResource{}
+var _ resource.ResourceWithIdentity = &AdministratorResource{}
+
+func NewAdministratorResource() resource.Resource {
+	return &AdministratorResource{}
+}
+
+type AdministratorResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type AdministratorResourceModel struct {
+	Email  types.String `tfsdk:"email"`
+	Name   types.String `tfsdk:"name"`
+	Roles  types.Set    `tfsdk:"roles"`
+	Status types.String `tfsdk:"status"`
+}
+
+// administratorIdentityModel identifies an administrator by email address.
+type administratorIdentityModel struct {
+	Email types.String `tfsdk:"email"`
+}
+
+func (r *AdministratorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_administrator"
+}
+
+func (r *AdministratorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Invites an administrator to the Landscape account and manages their roles. " +
+			"Destroying the resource disables the administrator; Landscape has no API to delete them outright. " +
+			"A pending invitation cannot be withdrawn through the API either, so destroying it only warns. " +
+			"Role changes are applied once the invitation has been accepted.",
+		Attributes: map[string]resourceschema.Attribute{
+			"email": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Email address the invitation is sent to. Identifies the administrator.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Display name the invitation is sent with. Administrators can rename themselves in Landscape, so the name is not refreshed after import and changing it only updates state.",
+			},
+			"roles": resourceschema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the roles this resource grants the administrator, e.g. `GlobalAdmin` or a `landscape_role` name. Roles granted elsewhere, e.g. by `landscape_role_membership`, are neither reported nor removed; import records every role the administrator holds.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"status": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`invited` until the invitation is accepted, then `active`.",
+			},
+		},
+	}
+}
+
+func (r *AdministratorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"email": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Email address of the administrator.",
+			},
+		},
+	}
+}
+
+func (r *AdministratorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *AdministratorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AdministratorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roles []string
+	resp.Diagnostics.Append(plan.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := r.client.LegacyInviteAdministrator(ctx, &landscape.LegacyInviteAdministratorParams{
+		Name:  plan.Name.ValueString(),
+		Email: plan.Email.ValueString(),
+		Roles: &roles,
+	})
+	resp.Diagnostics.Append(legacyActionDiags("Failed to invite administrator", rawResp, err)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Status = types.StringValue(administratorStatusInvited)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, administratorIdentityModel{Email: plan.Email})...)
+}
+
+func (r *AdministratorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AdministratorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, found, diags := r.lookup(ctx, state.Email.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		// Pending invitations are not listed, so an invited administrator is
+		// kept as-is. One that was active has been disabled or removed.
+		if state.Status.ValueString() != administratorStatusInvited {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, administratorIdentityModel{Email: state.Email})...)
+		return
+	}
+
+	var managed []string
+	if !state.Roles.IsNull() {
+		resp.Diagnostics.Append(state.Roles.ElementsAs(ctx, &managed, false)...)
+	}
+	roles, diags := types.SetValueFrom(ctx, types.StringType, managedRoles(managed, admin.Roles, state.Roles.IsNull()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The administrator owns their name once invited; only fill it in on import.
+	if state.Name.IsNull() {
+		state.Name = types.StringValue(admin.Name)
+	}
+	state.Roles = roles
+	state.Status = types.StringValue(administratorStatusActive)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, administratorIdentityModel{Email: state.Email})...)
+}
+
+func (r *AdministratorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state AdministratorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Status = state.Status
+
+	var want, have []string
+	resp.Diagnostics.Append(plan.Roles.ElementsAs(ctx, &want, false)...)
+	resp.Diagnostics.Append(state.Roles.ElementsAs(ctx, &have, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rolesChanged := len(stringsMissingFrom(want, have)) > 0 || len(stringsMissingFrom(have, want)) > 0
+	if rolesChanged && state.Status.ValueString() != administratorStatusActive {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("roles"),
+			"Invitation not yet accepted",
+			fmt.Sprintf("Roles of %s can only be changed once they accept the invitation.", plan.Email.ValueString()),
+		)
+		return
+	}
+
+	email := []string{plan.Email.ValueString()}
+	for _, role := range stringsMissingFrom(want, have) {
+		rawResp, err := r.client.LegacyAddPersonsToRole(ctx, &landscape.LegacyAddPersonsToRoleParams{Name: role, Persons: email})
+		resp.Diagnostics.Append(legacyActionDiags("Failed to add administrator to role", rawResp, err)...)
+	}
+	for _, role := range stringsMissingFrom(have, want) {
+		rawResp, err := r.client.LegacyRemovePersonsFromRole(ctx, &landscape.LegacyRemovePersonsFromRoleParams{Name: role, Persons: email})
+		resp.Diagnostics.Append(legacyActionDiags("Failed to remove administrator from role", rawResp, err)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, administratorIdentityModel{Email: plan.Email})...)
+}
+
+func (r *AdministratorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AdministratorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.disable(ctx, state)...)
+}
+
+// disable disables the administrator. A pending invitation has no
+// administrator to disable and cannot be withdrawn through the API, so it is
+// only dropped from state with a warning.
+func (r *AdministratorResource) disable(ctx context.Context, state AdministratorResourceModel) diag.Diagnostics {
+	email := state.Email.ValueString()
+	_, found, diags := r.lookup(ctx, email)
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		if state.Status.ValueString() == administratorStatusInvited {
+			diags.AddWarning(
+				"Invitation not withdrawn",
+				fmt.Sprintf("%s has not accepted the invitation yet. Landscape has no API to withdraw it, so revoke it in the Landscape UI.", email),
+			)
+		}
+		return diags
+	}
+
+	rawResp, err := r.client.LegacyDisableAdministrator(ctx, &landscape.LegacyDisableAdministratorParams{Email: email})
+	diags.Append(legacyActionDiags("Failed to disable administrator", rawResp, err)...)
+	return diags
+}
+
+// ImportState takes the email address of an active administrator, or an
+// `email` identity.
+func (r *AdministratorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("email"), path.Root("email"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status"), administratorStatusActive)...)
+}
+
+// lookup finds an administrator by email, ignoring case as Landscape does.
+func (r *AdministratorResource) lookup(ctx context.Context, email string) (legacyAdministrator, bool, diag.Diagnostics) {
+	administrators, diags := fetchAdministrators(ctx, r.client)
+	if diags.HasError() {
+		return legacyAdministrator{}, false, diags
+	}
+	for _, admin := range administrators {
+		if strings.EqualFold(admin.Email, email) {
+			return admin, true, diags
+		}
+	}
+	return legacyAdministrator{}, false, diags
+}
+
+// managedRoles returns the roles in managed that the administrator still
+// holds, so roles granted by other resources do not show up as drift. On
+// import nothing is managed yet and every held role is returned.
+func managedRoles(managed, held []string, imported bool) []string {
+	if imported {
+		return sortedStrings(held)
+	}
+	return stringsMissingFrom(managed, stringsMissingFrom(managed, held))
+}
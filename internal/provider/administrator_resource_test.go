@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+func TestAdministratorResourceMetadata(t *testing.T) {
+	res := NewAdministratorResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_administrator" {
+		t.Fatalf("expected resource type name landscape_administrator, got %q", resp.TypeName)
+	}
+}
+
+// Administrators can rename themselves, so a different name must never
+// destroy (disable) and re-invite the account.
+func TestAdministratorResourceNameDoesNotRequireReplace(t *testing.T) {
+	var resp pfresource.SchemaResponse
+	NewAdministratorResource().Schema(context.Background(), pfresource.SchemaRequest{}, &resp)
+
+	name, ok := resp.Schema.Attributes["name"].(resourceschema.StringAttribute)
+	if !ok {
+		t.Fatal("expected a name string attribute")
+	}
+	if len(name.PlanModifiers) != 0 {
+		t.Errorf("expected no plan modifiers on name, got %d", len(name.PlanModifiers))
+	}
+}
+
+func TestManagedRoles(t *testing.T) {
+	held := []string{"Auditor", "GlobalAdmin", "Support"}
+	cases := []struct {
+		name     string
+		managed  []string
+		imported bool
+		want     []string
+	}{
+		{"other roles ignored", []string{"GlobalAdmin"}, false, []string{"GlobalAdmin"}},
+		{"lost role reported", []string{"GlobalAdmin", "Operator"}, false, []string{"GlobalAdmin"}},
+		{"import takes all", nil, true, held},
+	}
+	for _, tc := range cases {
+		if got := managedRoles(tc.managed, held, tc.imported); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestAdministratorDisableSkipsPendingInvitation(t *testing.T) {
+	cases := []struct {
+		name        string
+		status      string
+		listed      bool
+		wantDisable bool
+		wantWarning bool
+	}{
+		{"active administrator", administratorStatusActive, true, true, false},
+		{"accepted invitation", administratorStatusInvited, true, true, false},
+		{"pending invitation", administratorStatusInvited, false, false, true},
+		{"already removed", administratorStatusActive, false, false, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			disabled := false
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Query().Get("action") {
+				case "GetAdministrators":
+					if tc.listed {
+						fmt.Fprint(w, `[{"email": "Jane@example.com", "name": "Jane", "roles": []}]`)
+					} else {
+						fmt.Fprint(w, `[]`)
+					}
+				case "DisableAdministrator":
+					disabled = true
+					fmt.Fprint(w, `{}`)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			client, err := landscape.NewClientWithResponses(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &AdministratorResource{client: client}
+			diags := r.disable(context.Background(), AdministratorResourceModel{
+				Email:  types.StringValue("jane@example.com"),
+				Status: types.StringValue(tc.status),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if disabled != tc.wantDisable {
+				t.Errorf("expected disable called %t, got %t", tc.wantDisable, disabled)
+			}
+			if got := diags.WarningsCount() > 0; got != tc.wantWarning {
+				t.Errorf("expected warning %t, got %t", tc.wantWarning, got)
+			}
+		})
+	}
+}
+
+func TestAccAdministratorResourceNoRoles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAdministratorResourceNoRolesConfig,
+				ExpectError: regexp.MustCompile(`(?i)at least 1`),
+			},
+		},
+	})
+}
+
+const testAccAdministratorResourceNoRolesConfig = `
+provider "landscape" {}
+
+resource "landscape_administrator" "test" {
+  name  = "Jane Operator"
+  email = "jane@example.com"
+  roles = []
+}
+`
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &AdministratorsDataSource{}
+var _ datasource.DataSourceWithConfigure = &AdministratorsDataSource{}
+
+func NewAdministratorsDataSource() datasource.DataSource {
+	return &AdministratorsDataSource{}
+}
+
+type AdministratorsDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type AdministratorsDataSourceModel struct {
+	Administrators types.List `tfsdk:"administrators"`
+}
+
+var administratorAttrTypes = map[string]attr.Type{
+	"id":    types.Int64Type,
+	"name":  types.StringType,
+	"email": types.StringType,
+	"roles": types.SetType{ElemType: types.StringType},
+}
+
+func (d *AdministratorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_administrators"
+}
+
+func (d *AdministratorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the administrators of the Landscape account. Pending invitations are not included.",
+		Attributes: map[string]schema.Attribute{
+			"administrators": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "All administrators.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The administrator ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Display name of the administrator.",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Email address of the administrator.",
+						},
+						"roles": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Names of the roles the administrator holds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AdministratorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *AdministratorsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	administrators, diags := fetchAdministrators(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(administrators))
+	for _, admin := range administrators {
+		roles, d := types.SetValueFrom(ctx, types.StringType, sortedStrings(admin.Roles))
+		resp.Diagnostics.Append(d...)
+		obj, d := types.ObjectValue(administratorAttrTypes, map[string]attr.Value{
+			"id":    types.Int64Value(admin.Id),
+			"name":  types.StringValue(admin.Name),
+			"email": types.StringValue(admin.Email),
+			"roles": roles,
+		})
+		resp.Diagnostics.Append(d...)
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: administratorAttrTypes}, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, AdministratorsDataSourceModel{Administrators: list})...)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestAdministratorsDataSourceMetadata(t *testing.T) {
+	dataSource := NewAdministratorsDataSource()
+
+	var resp datasource.MetadataResponse
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_administrators" {
+		t.Fatalf("expected data source type name landscape_administrators, got %q", resp.TypeName)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+// computerPageSize is the page size used when listing computers; it matches
+// the legacy API's default limit.
+const computerPageSize = 1000
+
+// legacyComputer is a computer as returned by the legacy GetComputers action,
+// which the generated client leaves untyped.
+type legacyComputer struct {
+	Id                 int64    `json:"id"`
+	Title              string   `json:"title"`
+	Hostname           string   `json:"hostname"`
+	AccessGroup        *string  `json:"access_group"`
+	Tags               []string `json:"tags"`
+	Distribution       *string  `json:"distribution"`
+	LastPingTime       *string  `json:"last_ping_time"`
+	RebootRequiredFlag *bool    `json:"reboot_required_flag"`
+}
+
+// computerAttrTypes is the Terraform attribute type map shared by the
+// computer data sources.
+var computerAttrTypes = map[string]attr.Type{
+	"id":              types.Int64Type,
+	"hostname":        types.StringType,
+	"title":           types.StringType,
+	"tags":            types.SetType{ElemType: types.StringType},
+	"access_group":    types.StringType,
+	"distribution":    types.StringType,
+	"last_ping_time":  types.StringType,
+	"reboot_required": types.BoolType,
+}
+
+// computerQuery joins a free-form Landscape search query with tag and access
+// group filters into a single space separated query string. Filter values are
+// quoted when needed, so names with spaces select the right computers.
+func computerQuery(query, tag, accessGroup string) string {
+	var tokens []string
+	if query != "" {
+		tokens = append(tokens, query)
+	}
+	if tag != "" {
+		tokens = append(tokens, "tag:"+searchValue(tag))
+	}
+	if accessGroup != "" {
+		tokens = append(tokens, "access-group:"+searchValue(accessGroup))
+	}
+	return strings.Join(tokens, " ")
+}
+
+// searchValue quotes a search filter value that contains whitespace or quotes
+// so Landscape reads it as a single value.
+func searchValue(value string) string {
+	if strings.ContainsAny(value, " \t\"") {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// fetchComputers returns every computer matching a Landscape search query,
+// following the legacy API's limit/offset pagination.
+func fetchComputers(ctx context.Context, client *landscape.ClientWithResponses, query string) ([]legacyComputer, diag.Diagnostics) {
+	var computers []legacyComputer
+	for offset := 0; ; offset += computerPageSize {
+		page, diags := fetchComputerPage(ctx, client, query, computerPageSize, offset)
+		if diags.HasError() {
+			return nil, diags
+		}
+		computers = append(computers, page...)
+		if len(page) < computerPageSize {
+			return computers, diags
+		}
+	}
+}
+
+// anyComputerMatches reports whether at least one computer matches a
+// Landscape search query, reading a single row.
+func anyComputerMatches(ctx context.Context, client *landscape.ClientWithResponses, query string) (bool, diag.Diagnostics) {
+	page, diags := fetchComputerPage(ctx, client, query, 1, 0)
+	return len(page) > 0, diags
+}
+
+// fetchComputerPage returns one page of the computers matching a query.
+func fetchComputerPage(ctx context.Context, client *landscape.ClientWithResponses, query string, limit, offset int) ([]legacyComputer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := &landscape.LegacyGetComputersParams{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+	if query != "" {
+		params.Query = &query
+	}
+
+	rawResp, err := client.LegacyGetComputers(ctx, params)
+	if err != nil {
+		diags.AddError("Failed to read computers", err.Error())
+		return nil, diags
+	}
+	body, _ := io.ReadAll(rawResp.Body)
+	rawResp.Body.Close()
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read computers", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return nil, diags
+	}
+
+	page, err := landscape.ParseLegacyResponse[[]legacyComputer](body)
+	if err != nil {
+		diags.AddError("Failed to parse computers response", err.Error())
+		return nil, diags
+	}
+	return page, diags
+}
+
+func computerAttrValues(ctx context.Context, c legacyComputer) (map[string]attr.Value, diag.Diagnostics) {
+	tagList := c.Tags
+	if tagList == nil {
+		tagList = []string{}
+	}
+	tags, diags := types.SetValueFrom(ctx, types.StringType, tagList)
+	return map[string]attr.Value{
+		"id":              types.Int64Value(c.Id),
+		"hostname":        types.StringValue(c.Hostname),
+		"title":           types.StringValue(c.Title),
+		"tags":            tags,
+		"access_group":    types.StringPointerValue(c.AccessGroup),
+		"distribution":    types.StringPointerValue(c.Distribution),
+		"last_ping_time":  types.StringPointerValue(c.LastPingTime),
+		"reboot_required": types.BoolPointerValue(c.RebootRequiredFlag),
+	}, diags
+}
+
+// computerIDQuery is the search query selecting a single computer by ID.
+func computerIDQuery(id int64) string {
+	return fmt.Sprintf("id:%d", id)
+}
+
+// computerIDsQuery is the search query selecting exactly the given computers,
+// in ascending ID order.
+func computerIDsQuery(ids []int64) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	tokens := make([]string, 0, len(sorted))
+	for _, id := range sorted {
+		tokens = append(tokens, computerIDQuery(id))
+	}
+	return strings.Join(tokens, " OR ")
+}
+
+// addComputerTags applies tags to every computer matching query.
+func addComputerTags(ctx context.Context, client *landscape.ClientWithResponses, query string, tags []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(tags) == 0 {
+		return diags
+	}
+
+	rawResp, err := client.LegacyAddTagsToComputers(ctx, &landscape.LegacyAddTagsToComputersParams{
+		Query: query,
+		Tags:  tags,
+	})
+	if err != nil {
+		diags.AddError("Failed to add tags to computers", err.Error())
+		return diags
+	}
+	defer rawResp.Body.Close()
+	if rawResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(rawResp.Body)
+		diags.AddError("Failed to add tags to computers", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+	}
+	return diags
+}
+
+// removeComputerTags removes tags from every computer matching query.
+func removeComputerTags(ctx context.Context, client *landscape.ClientWithResponses, query string, tags []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(tags) == 0 {
+		return diags
+	}
+
+	rawResp, err := client.LegacyRemoveTagsFromComputers(ctx, &landscape.LegacyRemoveTagsFromComputersParams{
+		Query: query,
+		Tags:  tags,
+	})
+	if err != nil {
+		diags.AddError("Failed to remove tags from computers", err.Error())
+		return diags
+	}
+	defer rawResp.Body.Close()
+	if rawResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(rawResp.Body)
+		diags.AddError("Failed to remove tags from computers", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+	}
+	return diags
+}
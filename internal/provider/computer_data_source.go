@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &ComputerDataSource{}
+var _ datasource.DataSourceWithConfigure = &ComputerDataSource{}
+
+func NewComputerDataSource() datasource.DataSource {
+	return &ComputerDataSource{}
+}
+
+type ComputerDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ComputerDataSourceModel struct {
+	Id             types.Int64  `tfsdk:"id"`
+	Hostname       types.String `tfsdk:"hostname"`
+	Title          types.String `tfsdk:"title"`
+	Tags           types.Set    `tfsdk:"tags"`
+	AccessGroup    types.String `tfsdk:"access_group"`
+	Distribution   types.String `tfsdk:"distribution"`
+	LastPingTime   types.String `tfsdk:"last_ping_time"`
+	RebootRequired types.Bool   `tfsdk:"reboot_required"`
+}
+
+func (d *ComputerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_computer"
+}
+
+func (d *ComputerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a registered Landscape computer by ID or hostname.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The computer ID. Exactly one of `id` or `hostname` must be set.",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("hostname")),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The computer's hostname. Must match exactly one computer.",
+			},
+			"title": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The computer's title in Landscape.",
+			},
+			"tags": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags applied to the computer.",
+			},
+			"access_group": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The access group the computer belongs to.",
+			},
+			"distribution": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The distribution release the computer runs (e.g. `24.04`).",
+			},
+			"last_ping_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the computer last pinged the Landscape server.",
+			},
+			"reboot_required": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the computer needs a reboot.",
+			},
+		},
+	}
+}
+
+func (d *ComputerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ComputerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ComputerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Landscape's free-text search matches hostnames loosely, so filter the
+	// results down to exact matches afterwards.
+	query := config.Hostname.ValueString()
+	if !config.Id.IsNull() {
+		query = fmt.Sprintf("id:%d", config.Id.ValueInt64())
+	}
+
+	computers, diags := fetchComputers(ctx, d.client, query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var matches []legacyComputer
+	for _, c := range computers {
+		if (!config.Id.IsNull() && c.Id == config.Id.ValueInt64()) ||
+			(!config.Hostname.IsNull() && c.Hostname == config.Hostname.ValueString()) {
+			matches = append(matches, c)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError("Computer not found", fmt.Sprintf("No computer matches %q", query))
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Multiple computers found",
+			fmt.Sprintf("%d computers have hostname %q; look the computer up by id instead", len(matches), config.Hostname.ValueString()),
+		)
+		return
+	}
+
+	values, diags := computerAttrValues(ctx, matches[0])
+	resp.Diagnostics.Append(diags...)
+	obj, diags := types.ObjectValue(computerAttrTypes, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ComputerDataSourceModel
+	resp.Diagnostics.Append(obj.As(ctx, &state, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestComputerDataSourceMetadata(t *testing.T) {
+	dataSource := NewComputerDataSource()
+
+	var resp datasource.MetadataResponse
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_computer" {
+		t.Fatalf("expected data source type name landscape_computer, got %q", resp.TypeName)
+	}
+}
+
+func TestAccComputerDataSourceIDAndHostname(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputerDataSourceIDAndHostnameConfig,
+				ExpectError: regexp.MustCompile(`(?i)exactly one`),
+			},
+		},
+	})
+}
+
+const testAccComputerDataSourceIDAndHostnameConfig = `
+provider "landscape" {}
+
+data "landscape_computer" "test" {
+  id       = 1
+  hostname = "web-01"
+}
+`
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &ComputerTagResource{}
+var _ resource.ResourceWithImportState = &ComputerTagResource{}
+var _ resource.ResourceWithIdentity = &ComputerTagResource{}
+
+func NewComputerTagResource() resource.Resource {
+	return &ComputerTagResource{}
+}
+
+type ComputerTagResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ComputerTagResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	ComputerId types.Int64  `tfsdk:"computer_id"`
+	Tag        types.String `tfsdk:"tag"`
+}
+
+// computerTagIdentityModel identifies a tag by its computer and name.
+type computerTagIdentityModel struct {
+	ComputerId types.Int64  `tfsdk:"computer_id"`
+	Tag        types.String `tfsdk:"tag"`
+}
+
+func (r *ComputerTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_computer_tag"
+}
+
+func (r *ComputerTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Applies a single tag to a computer without managing its other tags. Do not combine with `landscape_computer_tags` on the same computer.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier in the form `<computer_id>/<tag>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"computer_id": resourceschema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the computer to tag.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tag": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The tag to apply.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\S+$`), "must be a non-empty tag without whitespace"),
+				},
+			},
+		},
+	}
+}
+
+func (r *ComputerTagResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"computer_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "ID of the tagged computer.",
+			},
+			"tag": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The applied tag.",
+			},
+		},
+	}
+}
+
+func (r *ComputerTagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ComputerTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ComputerTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computers, diags := fetchComputers(ctx, r.client, computerIDQuery(plan.ComputerId.ValueInt64()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(computers) == 0 {
+		resp.Diagnostics.AddError("Computer not found", fmt.Sprintf("No computer has ID %d", plan.ComputerId.ValueInt64()))
+		return
+	}
+
+	resp.Diagnostics.Append(addComputerTags(ctx, r.client, computerIDQuery(plan.ComputerId.ValueInt64()), []string{plan.Tag.ValueString()})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%d/%s", plan.ComputerId.ValueInt64(), plan.Tag.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+}
+
+func (r *ComputerTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ComputerTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computers, diags := fetchComputers(ctx, r.client, computerIDQuery(state.ComputerId.ValueInt64()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A removed computer or a tag stripped outside Terraform both mean the
+	// membership no longer exists.
+	if len(computers) == 0 || !slices.Contains(computers[0].Tags, state.Tag.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Id = types.StringValue(fmt.Sprintf("%d/%s", state.ComputerId.ValueInt64(), state.Tag.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+}
+
+func (r *ComputerTagResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// All fields require replacement; Update is never called.
+}
+
+func (r *ComputerTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ComputerTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(removeComputerTags(ctx, r.client, computerIDQuery(state.ComputerId.ValueInt64()), []string{state.Tag.ValueString()})...)
+}
+
+// ImportState accepts `<computer_id>/<tag>` or an identity with both.
+func (r *ComputerTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var computerID int64
+	var tag string
+	if req.ID == "" && req.Identity != nil {
+		var identity computerTagIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		computerID, tag = identity.ComputerId.ValueInt64(), identity.Tag.ValueString()
+	} else {
+		idPart, tagPart, ok := strings.Cut(req.ID, "/")
+		parsed, err := strconv.ParseInt(idPart, 10, 64)
+		if !ok || err != nil || tagPart == "" {
+			resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected <computer_id>/<tag>, got: %s", req.ID))
+			return
+		}
+		computerID, tag = parsed, tagPart
+	}
+
+	state := ComputerTagResourceModel{
+		Id:         types.StringValue(fmt.Sprintf("%d/%s", computerID, tag)),
+		ComputerId: types.Int64Value(computerID),
+		Tag:        types.StringValue(tag),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+}
+
+func (m ComputerTagResourceModel) identity() computerTagIdentityModel {
+	return computerTagIdentityModel{
+		ComputerId: m.ComputerId,
+		Tag:        m.Tag,
+	}
+}
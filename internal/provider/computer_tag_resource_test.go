@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestComputerTagResourceMetadata(t *testing.T) {
+	res := NewComputerTagResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_computer_tag" {
+		t.Fatalf("expected resource type name landscape_computer_tag, got %q", resp.TypeName)
+	}
+}
+
+func TestAccComputerTagResourceWhitespaceTag(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputerTagResourceWhitespaceTagConfig,
+				ExpectError: regexp.MustCompile(`(?i)without whitespace`),
+			},
+		},
+	})
+}
+
+const testAccComputerTagResourceWhitespaceTagConfig = `
+provider "landscape" {}
+
+resource "landscape_computer_tag" "test" {
+  computer_id = 1
+  tag         = "web server"
+}
+`
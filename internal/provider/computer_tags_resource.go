@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &ComputerTagsResource{}
+var _ resource.ResourceWithImportState = &ComputerTagsResource{}
+var _ resource.ResourceWithIdentity = &ComputerTagsResource{}
+
+func NewComputerTagsResource() resource.Resource {
+	return &ComputerTagsResource{}
+}
+
+type ComputerTagsResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ComputerTagsResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ComputerId  types.Int64  `tfsdk:"computer_id"`
+	Query       types.String `tfsdk:"query"`
+	Tags        types.Set    `tfsdk:"tags"`
+	ComputerIds types.List   `tfsdk:"computer_ids"`
+}
+
+// computerTagsIdentityModel identifies the tagged computers by exactly one of
+// a computer ID or a search query.
+type computerTagsIdentityModel struct {
+	ComputerId types.Int64  `tfsdk:"computer_id"`
+	Query      types.String `tfsdk:"query"`
+}
+
+func (r *ComputerTagsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_computer_tags"
+}
+
+func (r *ComputerTagsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Authoritatively manages the tags on a computer, or on every computer matching a search query. " +
+			"Tags not listed in `tags` are removed from the selected computers. Use `landscape_computer_tag` to manage a single tag without taking ownership of the others.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The search query selecting the tagged computers.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"computer_id": resourceschema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the computer to tag. Exactly one of `computer_id` or `query` must be set.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("query")),
+				},
+			},
+			"query": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A Landscape search query selecting the computers to tag, e.g. `distribution:24.04`. Computers that start matching later are tagged on the next apply.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": resourceschema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The complete set of tags the selected computers should have.",
+			},
+			"computer_ids": resourceschema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the computers currently selected. Destroying the resource removes `tags` from these computers, not from whatever the query matches at that time.",
+			},
+		},
+	}
+}
+
+func (r *ComputerTagsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"computer_id": identityschema.Int64Attribute{
+				OptionalForImport: true,
+				Description:       "ID of the tagged computer. Set exactly one of `computer_id` or `query`.",
+			},
+			"query": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Search query selecting the tagged computers.",
+			},
+		},
+	}
+}
+
+func (r *ComputerTagsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ComputerTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ComputerTagsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+}
+
+func (r *ComputerTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ComputerTagsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computers, diags := fetchComputers(ctx, r.client, state.query())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(computers) == 0 && !state.ComputerId.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var desired []string
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, observedComputerTags(computers, desired))
+	resp.Diagnostics.Append(diags...)
+	ids, diags := computerIDList(ctx, computers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(state.query())
+	state.Tags = tags
+	state.ComputerIds = ids
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+}
+
+func (r *ComputerTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ComputerTagsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+}
+
+func (r *ComputerTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ComputerTagsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &tags, false)...)
+	var ids []int64
+	if !state.ComputerIds.IsNull() && !state.ComputerIds.IsUnknown() {
+		resp.Diagnostics.Append(state.ComputerIds.ElementsAs(ctx, &ids, false)...)
+	}
+	if resp.Diagnostics.HasError() || len(ids) == 0 {
+		return
+	}
+
+	// Untag the computers recorded at the last apply or refresh; a tag query
+	// may match different computers by now.
+	resp.Diagnostics.Append(removeComputerTags(ctx, r.client, computerIDsQuery(ids), tags)...)
+}
+
+// ImportState accepts a numeric computer ID or a search query, or an identity
+// with one of them.
+func (r *ComputerTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		var identity computerTagsIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if identity.ComputerId.IsNull() == identity.Query.IsNull() {
+			resp.Diagnostics.AddError("Invalid import identity", "Expected exactly one of computer_id or query.")
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("computer_id"), identity.ComputerId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("query"), identity.Query)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tags"), types.SetValueMust(types.StringType, nil))...)
+		return
+	}
+	if req.ID == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected a computer ID or a search query.")
+		return
+	}
+
+	if id, err := strconv.ParseInt(req.ID, 10, 64); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("computer_id"), id)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("query"), req.ID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tags"), types.SetValueMust(types.StringType, nil))...)
+}
+
+// apply makes the selected computers carry exactly the planned tags and
+// fills in the computed attributes.
+func (r *ComputerTagsResource) apply(ctx context.Context, plan *ComputerTagsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	query := plan.query()
+
+	var desired []string
+	diags.Append(plan.Tags.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	computers, d := fetchComputers(ctx, r.client, query)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if len(computers) == 0 && !plan.ComputerId.IsNull() {
+		diags.AddAttributeError(path.Root("computer_id"), "Computer not found", fmt.Sprintf("No computer has ID %d", plan.ComputerId.ValueInt64()))
+		return diags
+	}
+
+	keep := map[string]bool{}
+	for _, tag := range desired {
+		keep[tag] = true
+	}
+	var extra []string
+	for tag := range computerTagUnion(computers) {
+		if !keep[tag] {
+			extra = append(extra, tag)
+		}
+	}
+	sort.Strings(extra)
+
+	// Tag the computers by ID: removing tags can change which computers a
+	// tag query matches before the desired tags are added.
+	if len(computers) > 0 {
+		selected := computerIDsQuery(computerIDs(computers))
+		diags.Append(removeComputerTags(ctx, r.client, selected, extra)...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(addComputerTags(ctx, r.client, selected, desired)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	ids, d := computerIDList(ctx, computers)
+	diags.Append(d...)
+	plan.Id = types.StringValue(query)
+	plan.ComputerIds = ids
+	return diags
+}
+
+func (m ComputerTagsResourceModel) identity() computerTagsIdentityModel {
+	return computerTagsIdentityModel{
+		ComputerId: m.ComputerId,
+		Query:      m.Query,
+	}
+}
+
+func (m ComputerTagsResourceModel) query() string {
+	if !m.ComputerId.IsNull() {
+		return computerIDQuery(m.ComputerId.ValueInt64())
+	}
+	return m.Query.ValueString()
+}
+
+// computerTagUnion returns every tag carried by at least one computer.
+func computerTagUnion(computers []legacyComputer) map[string]bool {
+	union := map[string]bool{}
+	for _, c := range computers {
+		for _, tag := range c.Tags {
+			union[tag] = true
+		}
+	}
+	return union
+}
+
+// observedComputerTags collapses the tags of several computers into one set
+// that differs from desired whenever any computer is out of line: tags every
+// computer carries, plus unwanted tags carried by any of them. A desired tag
+// missing from one computer is therefore absent, and a stray tag is present.
+// With no computers selected there is nothing to drift from.
+func observedComputerTags(computers []legacyComputer, desired []string) []string {
+	if len(computers) == 0 {
+		return desired
+	}
+
+	wanted := map[string]bool{}
+	for _, tag := range desired {
+		wanted[tag] = true
+	}
+
+	observed := []string{}
+	for tag := range computerTagUnion(computers) {
+		if !wanted[tag] {
+			observed = append(observed, tag)
+			continue
+		}
+		onAll := true
+		for _, c := range computers {
+			has := false
+			for _, t := range c.Tags {
+				if t == tag {
+					has = true
+					break
+				}
+			}
+			if !has {
+				onAll = false
+				break
+			}
+		}
+		if onAll {
+			observed = append(observed, tag)
+		}
+	}
+	sort.Strings(observed)
+	return observed
+}
+
+func computerIDList(ctx context.Context, computers []legacyComputer) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.Int64Type, computerIDs(computers))
+}
+
+// computerIDs returns the IDs of computers in order.
+func computerIDs(computers []legacyComputer) []int64 {
+	ids := make([]int64, 0, len(computers))
+	for _, c := range computers {
+		ids = append(ids, c.Id)
+	}
+	return ids
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+func TestComputerTagsResourceMetadata(t *testing.T) {
+	res := NewComputerTagsResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_computer_tags" {
+		t.Fatalf("expected resource type name landscape_computer_tags, got %q", resp.TypeName)
+	}
+}
+
+func TestObservedComputerTags(t *testing.T) {
+	desired := []string{"prod", "web"}
+
+	cases := []struct {
+		name      string
+		computers []legacyComputer
+		want      []string
+	}{
+		{
+			name:      "in sync",
+			computers: []legacyComputer{{Tags: []string{"web", "prod"}}, {Tags: []string{"prod", "web"}}},
+			want:      []string{"prod", "web"},
+		},
+		{
+			name:      "missing on one computer",
+			computers: []legacyComputer{{Tags: []string{"web", "prod"}}, {Tags: []string{"prod"}}},
+			want:      []string{"prod"},
+		},
+		{
+			name:      "stray tag",
+			computers: []legacyComputer{{Tags: []string{"web", "prod"}}, {Tags: []string{"prod", "web", "old"}}},
+			want:      []string{"old", "prod", "web"},
+		},
+		{
+			name:      "no computers",
+			computers: nil,
+			want:      []string{"prod", "web"},
+		},
+	}
+	for _, c := range cases {
+		if got := observedComputerTags(c.computers, desired); !reflect.DeepEqual(got, c.want) {
+			t.Fatalf("%s: expected %v, got %v", c.name, c.want, got)
+		}
+	}
+}
+
+func TestComputerTagsApplyTagsByID(t *testing.T) {
+	calls := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		switch action := q.Get("action"); action {
+		case "GetComputers":
+			fmt.Fprint(w, `[{"id": 2, "tags": ["web", "old"]}, {"id": 1, "tags": ["web"]}]`)
+		default:
+			calls[action] = q.Get("query")
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := landscape.NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &ComputerTagsResource{client: client}
+	plan := ComputerTagsResourceModel{
+		ComputerId: types.Int64Null(),
+		Query:      types.StringValue("tag:web"),
+		Tags:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+	}
+
+	if diags := r.apply(context.Background(), &plan); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := map[string]string{
+		"RemoveTagsFromComputers": "id:1 OR id:2",
+		"AddTagsToComputers":      "id:1 OR id:2",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("expected %v, got %v", want, calls)
+	}
+	if got := plan.Id.ValueString(); got != "tag:web" {
+		t.Errorf("expected id to stay the query, got %q", got)
+	}
+}
+
+func TestAccComputerTagsResourceComputerIDAndQuery(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputerTagsResourceComputerIDAndQueryConfig,
+				ExpectError: regexp.MustCompile(`(?i)exactly one`),
+			},
+		},
+	})
+}
+
+const testAccComputerTagsResourceComputerIDAndQueryConfig = `
+provider "landscape" {}
+
+resource "landscape_computer_tags" "test" {
+  computer_id = 1
+  query       = "tag:web"
+  tags        = ["web"]
+}
+`
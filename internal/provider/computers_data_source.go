@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &ComputersDataSource{}
+var _ datasource.DataSourceWithConfigure = &ComputersDataSource{}
+
+func NewComputersDataSource() datasource.DataSource {
+	return &ComputersDataSource{}
+}
+
+type ComputersDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ComputersDataSourceModel struct {
+	Query       types.String `tfsdk:"query"`
+	Tag         types.String `tfsdk:"tag"`
+	AccessGroup types.String `tfsdk:"access_group"`
+	Ids         types.List   `tfsdk:"ids"`
+	Computers   types.List   `tfsdk:"computers"`
+}
+
+func (d *ComputersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_computers"
+}
+
+func (d *ComputersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists registered Landscape computers matching a search query. Filters are combined; with none set, every computer is returned.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A Landscape search query, e.g. `distribution:24.04 needs:reboot`.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return computers with this tag.",
+			},
+			"access_group": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return computers in this access group.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the matching computers, in the order Landscape returned them.",
+			},
+			"computers": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching computers.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The computer ID.",
+						},
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The computer's hostname.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The computer's title in Landscape.",
+						},
+						"tags": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Tags applied to the computer.",
+						},
+						"access_group": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The access group the computer belongs to.",
+						},
+						"distribution": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The distribution release the computer runs.",
+						},
+						"last_ping_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the computer last pinged the Landscape server.",
+						},
+						"reboot_required": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the computer needs a reboot.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ComputersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ComputersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ComputersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := computerQuery(config.Query.ValueString(), config.Tag.ValueString(), config.AccessGroup.ValueString())
+	computers, diags := fetchComputers(ctx, d.client, query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elemType := types.ObjectType{AttrTypes: computerAttrTypes}
+	ids := make([]attr.Value, 0, len(computers))
+	elems := make([]attr.Value, 0, len(computers))
+	for _, c := range computers {
+		values, d := computerAttrValues(ctx, c)
+		resp.Diagnostics.Append(d...)
+		obj, d := types.ObjectValue(computerAttrTypes, values)
+		resp.Diagnostics.Append(d...)
+		ids = append(ids, types.Int64Value(c.Id))
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idList, diags := types.ListValue(types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	computerList, diags := types.ListValue(elemType, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Ids = idList
+	config.Computers = computerList
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
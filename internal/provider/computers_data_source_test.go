@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestComputersDataSourceMetadata(t *testing.T) {
+	dataSource := NewComputersDataSource()
+
+	var resp datasource.MetadataResponse
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_computers" {
+		t.Fatalf("expected data source type name landscape_computers, got %q", resp.TypeName)
+	}
+}
+
+func TestComputerQuery(t *testing.T) {
+	cases := []struct {
+		query, tag, accessGroup string
+		want                    string
+	}{
+		{"", "", "", ""},
+		{"distribution:24.04", "", "", "distribution:24.04"},
+		{"", "web", "", "tag:web"},
+		{"needs:reboot", "web", "prod", "needs:reboot tag:web access-group:prod"},
+		{"", "web servers", "east \"prod\"", `tag:"web servers" access-group:"east \"prod\""`},
+	}
+	for _, c := range cases {
+		if got := computerQuery(c.query, c.tag, c.accessGroup); got != c.want {
+			t.Fatalf("computerQuery(%q, %q, %q) = %q, want %q", c.query, c.tag, c.accessGroup, got, c.want)
+		}
+	}
+}
+
+func TestComputerIDsQuery(t *testing.T) {
+	if got := computerIDsQuery([]int64{7, 3, 5}); got != "id:3 OR id:5 OR id:7" {
+		t.Errorf("expected IDs in ascending order, got %q", got)
+	}
+	if got := computerIDsQuery(nil); got != "" {
+		t.Errorf("expected an empty query, got %q", got)
+	}
+}
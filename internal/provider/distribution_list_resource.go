@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ list.ListResource = &DistributionListResource{}
+var _ list.ListResourceWithConfigure = &DistributionListResource{}
+
+func NewDistributionListResource() list.ListResource {
+	return &DistributionListResource{}
+}
+
+type DistributionListResource struct {
+	client *landscape.ClientWithResponses
+}
+
+// legacyDistribution is a distribution as returned by the legacy
+// GetDistributions action.
+type legacyDistribution struct {
+	Name        string  `json:"name"`
+	AccessGroup *string `json:"access_group"`
+}
+
+func (r *DistributionListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_distribution"
+}
+
+func (r *DistributionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the repository distributions in the account.",
+	}
+}
+
+func (r *DistributionListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResource(req, resp)
+}
+
+func (r *DistributionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	distributions, diags := fetchDistributions(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, distributions, func(d legacyDistribution, result *list.ListResult) {
+		result.DisplayName = d.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, nameIdentityModel{Name: types.StringValue(d.Name)})...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, DistributionResourceModel{
+				Name:        types.StringValue(d.Name),
+				AccessGroup: types.StringPointerValue(d.AccessGroup),
+			})...)
+		}
+	})
+}
+
+// fetchDistributions returns every repository distribution in the account.
+func fetchDistributions(ctx context.Context, client *landscape.ClientWithResponses) ([]legacyDistribution, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawResp, err := client.LegacyGetDistributions(ctx, &landscape.LegacyGetDistributionsParams{})
+	if err != nil {
+		diags.AddError("Failed to read distributions", err.Error())
+		return nil, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read distributions", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return nil, diags
+	}
+
+	distributions, err := landscape.ParseLegacyResponse[[]legacyDistribution](body)
+	if err != nil {
+		diags.AddError("Failed to parse distributions response", err.Error())
+		return nil, diags
+	}
+	return distributions, diags
+}
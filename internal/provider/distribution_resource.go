@@ -21,6 +21,7 @@ import (
 
 var _ resource.Resource = &DistributionResource{}
 var _ resource.ResourceWithImportState = &DistributionResource{}
+var _ resource.ResourceWithIdentity = &DistributionResource{}
 
 func NewDistributionResource() resource.Resource {
 	return &DistributionResource{}
@@ -61,6 +62,10 @@ func (r *DistributionResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *DistributionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Name of the distribution.")
+}
+
 func (r *DistributionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -104,6 +109,7 @@ func (r *DistributionResource) Create(ctx context.Context, req resource.CreateRe
 			}
 			if jsonErr := json.Unmarshal(body, &apiErr); jsonErr == nil && apiErr.Error == "DuplicateDistribution" {
 				resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+				resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
 				return
 			}
 		}
@@ -112,6 +118,7 @@ func (r *DistributionResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
 }
 
 func (r *DistributionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -144,6 +151,7 @@ func (r *DistributionResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
 }
 
 func (r *DistributionResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
@@ -172,5 +180,5 @@ func (r *DistributionResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *DistributionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
 }
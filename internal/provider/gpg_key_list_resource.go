@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ list.ListResource = &GPGKeyListResource{}
+var _ list.ListResourceWithConfigure = &GPGKeyListResource{}
+
+func NewGPGKeyListResource() list.ListResource {
+	return &GPGKeyListResource{}
+}
+
+type GPGKeyListResource struct {
+	client *landscape.ClientWithResponses
+}
+
+// legacyGPGKey is a GPG key as returned by the legacy GetGPGKeys action.
+type legacyGPGKey struct {
+	Name string `json:"name"`
+}
+
+func (r *GPGKeyListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gpg_key"
+}
+
+func (r *GPGKeyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the GPG keys in the account. The private key material is never returned, so listed resources have a null `material`.",
+	}
+}
+
+func (r *GPGKeyListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResource(req, resp)
+}
+
+func (r *GPGKeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	keys, diags := fetchGPGKeys(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, keys, func(k legacyGPGKey, result *list.ListResult) {
+		result.DisplayName = k.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, nameIdentityModel{Name: types.StringValue(k.Name)})...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, GPGKeyResourceModel{
+				Name:     types.StringValue(k.Name),
+				Material: types.StringNull(),
+			})...)
+		}
+	})
+}
+
+// fetchGPGKeys returns every GPG key in the account.
+func fetchGPGKeys(ctx context.Context, client *landscape.ClientWithResponses) ([]legacyGPGKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawResp, err := client.LegacyGetGPGKeys(ctx, &landscape.LegacyGetGPGKeysParams{})
+	if err != nil {
+		diags.AddError("Failed to read GPG keys", err.Error())
+		return nil, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read GPG keys", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return nil, diags
+	}
+
+	keys, err := landscape.ParseLegacyResponse[[]legacyGPGKey](body)
+	if err != nil {
+		diags.AddError("Failed to parse GPG keys response", err.Error())
+		return nil, diags
+	}
+	return keys, diags
+}
@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &GPGKeyResource{}
 var _ resource.ResourceWithImportState = &GPGKeyResource{}
+var _ resource.ResourceWithIdentity = &GPGKeyResource{}
 
 func NewGPGKeyResource() resource.Resource {
 	return &GPGKeyResource{}
@@ -61,6 +62,10 @@ func (r *GPGKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *GPGKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Name of the GPG key.")
+}
+
 func (r *GPGKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -97,6 +102,7 @@ func (r *GPGKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
 }
 
 func (r *GPGKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -129,6 +135,7 @@ func (r *GPGKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
 }
 
 func (r *GPGKeyResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
@@ -157,5 +164,5 @@ func (r *GPGKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *GPGKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
 }
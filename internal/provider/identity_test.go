@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestResourcesHaveIdentity(t *testing.T) {
+	ctx := context.Background()
+	p := &landscapeProvider{}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var metaResp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "landscape"}, &metaResp)
+
+		withIdentity, ok := r.(resource.ResourceWithIdentity)
+		if !ok {
+			t.Errorf("resource %s has no identity", metaResp.TypeName)
+			continue
+		}
+
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		var identityResp resource.IdentitySchemaResponse
+		withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+		if identityResp.Diagnostics.HasError() {
+			t.Errorf("resource %s: identity schema: %v", metaResp.TypeName, identityResp.Diagnostics)
+			continue
+		}
+
+		// Identity is copied from the resource's own attributes, so every
+		// identity attribute must exist in the schema with the same type.
+		for name, identityAttr := range identityResp.IdentitySchema.Attributes {
+			attr, ok := schemaResp.Schema.Attributes[name]
+			if !ok {
+				t.Errorf("resource %s: identity attribute %q is not a resource attribute", metaResp.TypeName, name)
+				continue
+			}
+			if !attr.GetType().Equal(identityAttr.GetType()) {
+				t.Errorf("resource %s: identity attribute %q is %s, resource attribute is %s",
+					metaResp.TypeName, name, identityAttr.GetType(), attr.GetType())
+			}
+		}
+	}
+}
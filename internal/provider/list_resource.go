@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+// listResults streams one list result per item, stopping at the request
+// limit. fill sets the result's display name and identity and, when the
+// request includes resources, its state.
+func listResults[T any](ctx context.Context, req list.ListRequest, items []T, fill func(T, *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			result := req.NewListResult(ctx)
+			fill(item, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// configureListResource returns the client passed to a list resource's
+// Configure method, or nil before the provider is configured.
+func configureListResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *landscape.ClientWithResponses {
+	if req.ProviderData == nil {
+		return nil
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return nil
+	}
+	return client
+}
+
+// compileTitleRegex compiles an optional `title_regex` list argument. A null
+// value yields a nil regexp, which matches every title.
+func compileTitleRegex(value types.String) (*regexp.Regexp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() {
+		return nil, diags
+	}
+	re, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("title_regex"), "Invalid title_regex", err.Error())
+	}
+	return re, diags
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListResourcesMatchResourcesWithIdentity(t *testing.T) {
+	ctx := context.Background()
+	p := &landscapeProvider{}
+
+	resources := map[string]resource.Resource{}
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var resp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+		resources[resp.TypeName] = r
+	}
+
+	for _, newListResource := range p.ListResources(ctx) {
+		var resp resource.MetadataResponse
+		newListResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+		r, ok := resources[resp.TypeName]
+		if !ok {
+			t.Errorf("list resource %s has no matching resource", resp.TypeName)
+			continue
+		}
+		if _, ok := r.(resource.ResourceWithIdentity); !ok {
+			t.Errorf("resource %s is listable but has no identity", resp.TypeName)
+		}
+	}
+}
+
+func TestListResultsStopsAtLimit(t *testing.T) {
+	ctx := context.Background()
+	req := list.ListRequest{
+		Limit:                  2,
+		ResourceSchema:         resourceschema.Schema{},
+		ResourceIdentitySchema: nameIdentitySchema("Name."),
+	}
+
+	var names []string
+	for result := range listResults(ctx, req, []string{"a", "b", "c"}, func(name string, result *list.ListResult) {
+		result.DisplayName = name
+		result.Diagnostics.Append(result.Identity.Set(ctx, nameIdentityModel{Name: types.StringValue(name)})...)
+	}) {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+		names = append(names, result.DisplayName)
+	}
+
+	if len(names) != 2 || names[0] != "a" || names[1] != "b" {
+		t.Fatalf("expected [a b], got %v", names)
+	}
+}
@@ -0,0 +1,455 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &PackageProfileResource{}
+var _ resource.ResourceWithImportState = &PackageProfileResource{}
+var _ resource.ResourceWithIdentity = &PackageProfileResource{}
+
+func NewPackageProfileResource() resource.Resource {
+	return &PackageProfileResource{}
+}
+
+type PackageProfileResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type PackageProfileResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Title        types.String `tfsdk:"title"`
+	Description  types.String `tfsdk:"description"`
+	AccessGroup  types.String `tfsdk:"access_group"`
+	Constraints  types.List   `tfsdk:"constraints"`
+	AllComputers types.Bool   `tfsdk:"all_computers"`
+	Tags         types.Set    `tfsdk:"tags"`
+}
+
+type packageConstraintModel struct {
+	Type     types.String `tfsdk:"type"`
+	Package  types.String `tfsdk:"package"`
+	Relation types.String `tfsdk:"relation"`
+	Version  types.String `tfsdk:"version"`
+}
+
+var packageConstraintAttrTypes = map[string]attr.Type{
+	"type":     types.StringType,
+	"package":  types.StringType,
+	"relation": types.StringType,
+	"version":  types.StringType,
+}
+
+// legacyPackageProfile is a package profile as returned by the legacy
+// GetPackageProfiles action.
+type legacyPackageProfile struct {
+	Name         string                    `json:"name"`
+	Title        string                    `json:"title"`
+	Description  *string                   `json:"description"`
+	AccessGroup  *string                   `json:"access_group"`
+	AllComputers bool                      `json:"all_computers"`
+	Tags         []string                  `json:"tags"`
+	Constraints  []legacyPackageConstraint `json:"constraints"`
+}
+
+type legacyPackageConstraint struct {
+	Constraint string  `json:"constraint"`
+	Package    string  `json:"package"`
+	Rule       *string `json:"rule"`
+	Version    *string `json:"version"`
+}
+
+func (r *PackageProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_package_profile"
+}
+
+func (r *PackageProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Manages a Landscape package profile, which keeps packages installed, removed or pinned on the computers it targets.",
+		Attributes: map[string]resourceschema.Attribute{
+			"name": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The slug name of the profile returned by the API.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Title for the package profile.",
+			},
+			"description": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the profile.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"access_group": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Access group to create the profile in.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"constraints": resourceschema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Package constraints enforced by the profile. Constraints added or removed outside Terraform show up as drift.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: resourceschema.NestedAttributeObject{
+					Attributes: map[string]resourceschema.Attribute{
+						"type": resourceschema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Constraint type: `depends`, `conflicts`, `breaks` or `predepends`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("depends", "conflicts", "breaks", "predepends"),
+							},
+						},
+						"package": resourceschema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the package.",
+						},
+						"relation": resourceschema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Version relation: `<<`, `<=`, `=`, `>=` or `>>`. Requires `version`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("<<", "<=", "=", ">=", ">>"),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("version")),
+							},
+						},
+						"version": resourceschema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Package version the relation compares against. Requires `relation`.",
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("relation")),
+							},
+						},
+					},
+				},
+			},
+			"all_computers": resourceschema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to apply the profile to all computers.",
+			},
+			"tags": resourceschema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Tags used to target computers.",
+			},
+		},
+	}
+}
+
+func (r *PackageProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Name of the package profile.")
+}
+
+func (r *PackageProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *PackageProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PackageProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	constraints, diags := packageConstraintSpecs(ctx, plan.Constraints)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createParams := &landscape.LegacyCreatePackageProfileParams{
+		Title:       plan.Title.ValueString(),
+		Description: plan.Description.ValueString(),
+		Constraints: &constraints,
+	}
+	if !plan.AccessGroup.IsNull() && !plan.AccessGroup.IsUnknown() {
+		v := plan.AccessGroup.ValueString()
+		createParams.AccessGroup = &v
+	}
+
+	rawResp, err := r.client.LegacyCreatePackageProfile(ctx, createParams)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create package profile", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Failed to create package profile", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return
+	}
+
+	profile, err := landscape.ParseLegacyResponse[legacyPackageProfile](body)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse package profile response", err.Error())
+		return
+	}
+	if profile.Name == "" {
+		resp.Diagnostics.AddError("Failed to parse package profile response", "response missing 'name' field")
+		return
+	}
+	plan.Name = types.StringValue(profile.Name)
+
+	diags = reconcileProfileTargeting(ctx, plan.Tags, types.SetNull(types.StringType), plan.AllComputers, types.BoolNull(),
+		r.associate(profile.Name), r.disassociate(profile.Name))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PackageProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PackageProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := []string{state.Name.ValueString()}
+	rawResp, err := r.client.LegacyGetPackageProfiles(ctx, &landscape.LegacyGetPackageProfilesParams{
+		Names: &names,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read package profile", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Failed to read package profile", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return
+	}
+
+	profiles, err := landscape.ParseLegacyResponse[[]legacyPackageProfile](body)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse package profile response", err.Error())
+		return
+	}
+	var profile *legacyPackageProfile
+	for i := range profiles {
+		if profiles[i].Name == state.Name.ValueString() {
+			profile = &profiles[i]
+		}
+	}
+	if profile == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	constraints, diags := packageConstraintsToList(ctx, profile.Constraints, state.Constraints)
+	resp.Diagnostics.Append(diags...)
+	tags, diags := types.SetValueFrom(ctx, types.StringType, sortedStrings(profile.Tags))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Title = types.StringValue(profile.Title)
+	if profile.Description != nil && *profile.Description != "" {
+		state.Description = types.StringValue(*profile.Description)
+	} else if !state.Description.IsNull() {
+		state.Description = types.StringValue("")
+	}
+	if profile.AccessGroup != nil && !state.AccessGroup.IsNull() {
+		state.AccessGroup = types.StringValue(*profile.AccessGroup)
+	}
+	state.Constraints = constraints
+	state.AllComputers = types.BoolValue(profile.AllComputers)
+	state.Tags = tags
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
+}
+
+func (r *PackageProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PackageProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileName := state.Name.ValueString()
+	plan.Name = state.Name
+
+	want, diags := packageConstraintSpecs(ctx, plan.Constraints)
+	resp.Diagnostics.Append(diags...)
+	have, diags := packageConstraintSpecs(ctx, state.Constraints)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	add := stringsMissingFrom(want, have)
+	remove := stringsMissingFrom(have, want)
+	if !plan.Title.Equal(state.Title) || len(add) > 0 || len(remove) > 0 {
+		editParams := &landscape.LegacyEditPackageProfileParams{
+			Name: profileName,
+		}
+		if !plan.Title.Equal(state.Title) {
+			v := plan.Title.ValueString()
+			editParams.Title = &v
+		}
+		if len(add) > 0 {
+			editParams.AddConstraints = &add
+		}
+		if len(remove) > 0 {
+			editParams.RemoveConstraints = &remove
+		}
+		rawResp, err := r.client.LegacyEditPackageProfile(ctx, editParams)
+		resp.Diagnostics.Append(legacyActionDiags("Failed to update package profile", rawResp, err)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(reconcileProfileTargeting(ctx, plan.Tags, state.Tags, plan.AllComputers, state.AllComputers,
+		r.associate(profileName), r.disassociate(profileName))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *PackageProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PackageProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := r.client.LegacyRemovePackageProfile(ctx, &landscape.LegacyRemovePackageProfileParams{
+		Name: state.Name.ValueString(),
+	})
+	resp.Diagnostics.Append(legacyActionDiags("Failed to remove package profile", rawResp, err)...)
+}
+
+func (r *PackageProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+}
+
+func (r *PackageProfileResource) associate(name string) profileAssociationFunc {
+	return func(ctx context.Context, tags *[]string, allComputers *bool) (*http.Response, error) {
+		return r.client.LegacyAssociatePackageProfile(ctx, &landscape.LegacyAssociatePackageProfileParams{
+			Name:         name,
+			Tags:         tags,
+			AllComputers: allComputers,
+		})
+	}
+}
+
+func (r *PackageProfileResource) disassociate(name string) profileAssociationFunc {
+	return func(ctx context.Context, tags *[]string, allComputers *bool) (*http.Response, error) {
+		return r.client.LegacyDisassociatePackageProfile(ctx, &landscape.LegacyDisassociatePackageProfileParams{
+			Name:         name,
+			Tags:         tags,
+			AllComputers: allComputers,
+		})
+	}
+}
+
+// spec renders a constraint in the "depends foo >= 1.0" form the legacy API
+// expects.
+func (c packageConstraintModel) spec() string {
+	parts := []string{c.Type.ValueString(), c.Package.ValueString()}
+	if !c.Relation.IsNull() && !c.Version.IsNull() {
+		parts = append(parts, c.Relation.ValueString(), c.Version.ValueString())
+	}
+	return strings.Join(parts, " ")
+}
+
+func packageConstraintSpecs(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if list.IsNull() || list.IsUnknown() {
+		return nil, diags
+	}
+
+	var constraints []packageConstraintModel
+	diags.Append(list.ElementsAs(ctx, &constraints, false)...)
+	specs := make([]string, 0, len(constraints))
+	for _, c := range constraints {
+		specs = append(specs, c.spec())
+	}
+	return specs, diags
+}
+
+// packageConstraintsToList converts the remote constraints, keeping the order
+// of prior where the same constraints are still present so reordering by
+// Landscape does not show up as drift. New remote constraints go last.
+func packageConstraintsToList(ctx context.Context, remote []legacyPackageConstraint, prior types.List) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: packageConstraintAttrTypes}
+
+	bySpec := map[string]packageConstraintModel{}
+	var remoteOrder []string
+	for _, c := range remote {
+		m := packageConstraintModel{
+			Type:     types.StringValue(c.Constraint),
+			Package:  types.StringValue(c.Package),
+			Relation: types.StringNull(),
+			Version:  types.StringNull(),
+		}
+		if c.Rule != nil && *c.Rule != "" && c.Version != nil && *c.Version != "" {
+			m.Relation = types.StringValue(*c.Rule)
+			m.Version = types.StringValue(*c.Version)
+		}
+		spec := m.spec()
+		if _, dup := bySpec[spec]; !dup {
+			remoteOrder = append(remoteOrder, spec)
+		}
+		bySpec[spec] = m
+	}
+
+	priorSpecs, diags := packageConstraintSpecs(ctx, prior)
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+
+	ordered := make([]packageConstraintModel, 0, len(bySpec))
+	seen := map[string]bool{}
+	for _, spec := range append(priorSpecs, remoteOrder...) {
+		if m, ok := bySpec[spec]; ok && !seen[spec] {
+			ordered = append(ordered, m)
+			seen[spec] = true
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, elemType, ordered)
+	diags.Append(d...)
+	return list, diags
+}
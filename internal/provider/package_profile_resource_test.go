@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPackageProfileResourceMetadata(t *testing.T) {
+	res := NewPackageProfileResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_package_profile" {
+		t.Fatalf("expected resource type name landscape_package_profile, got %q", resp.TypeName)
+	}
+}
+
+func TestPackageConstraintsToListKeepsPriorOrder(t *testing.T) {
+	ctx := context.Background()
+	ge := ">="
+	version := "1.0"
+	remote := []legacyPackageConstraint{
+		{Constraint: "conflicts", Package: "telnetd"},
+		{Constraint: "depends", Package: "curl", Rule: &ge, Version: &version},
+		{Constraint: "breaks", Package: "extra"},
+	}
+
+	prior, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: packageConstraintAttrTypes}, []packageConstraintModel{
+		{Type: types.StringValue("depends"), Package: types.StringValue("curl"), Relation: types.StringValue(">="), Version: types.StringValue("1.0")},
+		{Type: types.StringValue("conflicts"), Package: types.StringValue("telnetd"), Relation: types.StringNull(), Version: types.StringNull()},
+		{Type: types.StringValue("depends"), Package: types.StringValue("gone"), Relation: types.StringNull(), Version: types.StringNull()},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	list, diags := packageConstraintsToList(ctx, remote, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got, diags := packageConstraintSpecs(ctx, list)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := []string{"depends curl >= 1.0", "conflicts telnetd", "breaks extra"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestAccPackageProfileResourceVersionWithoutRelation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPackageProfileResourceVersionWithoutRelationConfig,
+				ExpectError: regexp.MustCompile(`(?i)relation`),
+			},
+		},
+	})
+}
+
+const testAccPackageProfileResourceVersionWithoutRelationConfig = `
+provider "landscape" {}
+
+resource "landscape_package_profile" "test" {
+  title = "web"
+
+  constraints = [
+    {
+      type    = "depends"
+      package = "nginx"
+      version = "1.24"
+    },
+  ]
+}
+`
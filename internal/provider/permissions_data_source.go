@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &PermissionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &PermissionsDataSource{}
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &PermissionsDataSource{}
+}
+
+type PermissionsDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type PermissionsDataSourceModel struct {
+	Names       types.Set  `tfsdk:"names"`
+	Permissions types.List `tfsdk:"permissions"`
+}
+
+var permissionAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"title": types.StringType,
+}
+
+func (d *PermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *PermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the permissions that can be granted to a `landscape_role`.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of all permissions, for use in `contains()` checks and validation.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "All permissions, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The permission name, as used in `landscape_role.permissions`.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable description of the permission.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *PermissionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	permissions, diags := fetchPermissions(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make([]attr.Value, 0, len(permissions))
+	elems := make([]attr.Value, 0, len(permissions))
+	for _, p := range permissions {
+		names = append(names, types.StringValue(p.Name))
+		obj, d := types.ObjectValue(permissionAttrTypes, map[string]attr.Value{
+			"name":  types.StringValue(p.Name),
+			"title": types.StringValue(p.Title),
+		})
+		resp.Diagnostics.Append(d...)
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameSet, diags := types.SetValue(types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: permissionAttrTypes}, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := PermissionsDataSourceModel{
+		Names:       nameSet,
+		Permissions: list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// profileAssociationFunc calls one of the legacy Associate<X>Profile or
+// Disassociate<X>Profile actions, which all take a profile name plus optional
+// tags and all_computers flag.
+type profileAssociationFunc func(ctx context.Context, tags *[]string, allComputers *bool) (*http.Response, error)
+
+// reconcileProfileTargeting associates and disassociates tags and the
+// all_computers flag so a legacy profile's targeting moves from prior to
+// planned. Pass null prior values when the profile was just created.
+func reconcileProfileTargeting(ctx context.Context, plannedTags, priorTags types.Set, plannedAll, priorAll types.Bool, associate, disassociate profileAssociationFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var want, have []string
+	if !plannedTags.IsNull() && !plannedTags.IsUnknown() {
+		diags.Append(plannedTags.ElementsAs(ctx, &want, false)...)
+	}
+	if !priorTags.IsNull() && !priorTags.IsUnknown() {
+		diags.Append(priorTags.ElementsAs(ctx, &have, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	var addAll, removeAll *bool
+	if plannedAll.ValueBool() && !priorAll.ValueBool() {
+		v := true
+		addAll = &v
+	}
+	if !plannedAll.ValueBool() && priorAll.ValueBool() {
+		v := true
+		removeAll = &v
+	}
+
+	if add := stringsMissingFrom(want, have); len(add) > 0 || addAll != nil {
+		var tags *[]string
+		if len(add) > 0 {
+			tags = &add
+		}
+		rawResp, err := associate(ctx, tags, addAll)
+		diags.Append(legacyActionDiags("Failed to associate profile", rawResp, err)...)
+	}
+	if remove := stringsMissingFrom(have, want); len(remove) > 0 || removeAll != nil {
+		var tags *[]string
+		if len(remove) > 0 {
+			tags = &remove
+		}
+		rawResp, err := disassociate(ctx, tags, removeAll)
+		diags.Append(legacyActionDiags("Failed to disassociate profile", rawResp, err)...)
+	}
+	return diags
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,7 +22,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &landscapeProvider{}
+	_ provider.Provider                  = &landscapeProvider{}
+	_ provider.ProviderWithListResources = &landscapeProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -297,10 +299,11 @@ func (p *landscapeProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Make the Landscape API client available during DataSource and Resource
-	// type Configure methods.
+	// Make the Landscape API client available during DataSource, Resource and
+	// ListResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
 
 }
 
@@ -310,7 +313,17 @@ func (p *landscapeProvider) DataSources(_ context.Context) []func() datasource.D
 		NewScriptV1DataSource,
 		NewScriptV2DataSource,
 		NewScriptV2AttachmentDataSource,
+		NewScriptV2VersionsDataSource,
 		NewScriptProfileDataSource,
+		NewComputerDataSource,
+		NewComputersDataSource,
+		NewAccessGroupDataSource,
+		NewPermissionsDataSource,
+		NewAdministratorsDataSource,
+		NewSecurityProfileAuditResultsDataSource,
+		NewActivitiesDataSource,
+		NewScriptsDataSource,
+		NewScriptProfilesDataSource,
 	}
 }
 
@@ -325,5 +338,31 @@ func (p *landscapeProvider) Resources(_ context.Context) []func() resource.Resou
 		NewDistributionResource,
 		NewSeriesResource,
 		NewRepositoryProfileResource,
+		NewComputerTagsResource,
+		NewComputerTagResource,
+		NewAccessGroupResource,
+		NewRoleResource,
+		NewRoleMembershipResource,
+		NewAdministratorResource,
+		NewPackageProfileResource,
+		NewUpgradeProfileResource,
+		NewRemovalProfileResource,
+		NewRebootProfileResource,
+		NewWSLProfileResource,
+		NewSecurityProfileResource,
+		NewScriptExecutionResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider, used
+// by Terraform to discover existing objects for import.
+func (p *landscapeProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewScriptV1ListResource,
+		NewScriptV2ListResource,
+		NewScriptProfileListResource,
+		NewDistributionListResource,
+		NewGPGKeyListResource,
+		NewRepositoryProfileListResource,
 	}
 }
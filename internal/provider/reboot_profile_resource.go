@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &RebootProfileResource{}
+var _ resource.ResourceWithImportState = &RebootProfileResource{}
+var _ resource.ResourceWithIdentity = &RebootProfileResource{}
+var _ resource.ResourceWithValidateConfig = &RebootProfileResource{}
+var _ resource.ResourceWithModifyPlan = &RebootProfileResource{}
+
+func NewRebootProfileResource() resource.Resource {
+	return &RebootProfileResource{}
+}
+
+// RebootProfileResource manages reboot profiles through the REST API, which
+// the generated client does not cover yet.
+type RebootProfileResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type RebootProfileResourceModel struct {
+	Id                 types.Int64  `tfsdk:"id"`
+	Title              types.String `tfsdk:"title"`
+	Every              types.String `tfsdk:"every"`
+	OnDays             types.Set    `tfsdk:"on_days"`
+	AtHour             types.Int64  `tfsdk:"at_hour"`
+	AtMinute           types.Int64  `tfsdk:"at_minute"`
+	DeliverWithin      types.Int64  `tfsdk:"deliver_within"`
+	DeliverDelayWindow types.Int64  `tfsdk:"deliver_delay_window"`
+	AccessGroup        types.String `tfsdk:"access_group"`
+	AllComputers       types.Bool   `tfsdk:"all_computers"`
+	Tags               types.Set    `tfsdk:"tags"`
+	NextRun            types.String `tfsdk:"next_run"`
+}
+
+// rebootProfileBody is the request body for creating and editing a reboot
+// profile.
+type rebootProfileBody struct {
+	Title              string   `json:"title"`
+	Every              string   `json:"every"`
+	OnDays             []string `json:"on_days"`
+	AtHour             *int     `json:"at_hour,omitempty"`
+	AtMinute           int      `json:"at_minute"`
+	DeliverWithin      int      `json:"deliver_within"`
+	RandomizeDelivery  bool     `json:"randomize_delivery"`
+	DeliverDelayWindow int      `json:"deliver_delay_window"`
+	AccessGroup        *string  `json:"access_group,omitempty"`
+	AllComputers       bool     `json:"all_computers"`
+	Tags               []string `json:"tags"`
+}
+
+type rebootProfile struct {
+	Id                 int      `json:"id"`
+	Title              string   `json:"title"`
+	Every              string   `json:"every"`
+	OnDays             []string `json:"on_days"`
+	AtHour             *int     `json:"at_hour"`
+	AtMinute           int      `json:"at_minute"`
+	DeliverWithin      int      `json:"deliver_within"`
+	DeliverDelayWindow int      `json:"deliver_delay_window"`
+	AccessGroup        *string  `json:"access_group"`
+	AllComputers       bool     `json:"all_computers"`
+	Tags               []string `json:"tags"`
+	NextRun            *string  `json:"next_run"`
+}
+
+func (r *RebootProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reboot_profile"
+}
+
+func (r *RebootProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Manages a Landscape reboot profile, which reboots the computers it targets on a schedule.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the reboot profile.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"title": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Title for the reboot profile.",
+			},
+			"every": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How often the profile runs: `week` or `month`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("week", "month"),
+				},
+			},
+			"on_days": resourceschema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Days of the week to reboot on (`mo`, `tu`, `we`, `th`, `fr`, `sa`, `su`).",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(scheduleWeekdays...)),
+				},
+			},
+			"at_hour": resourceschema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Hour (0-23) to reboot at.",
+				Validators: []validator.Int64{
+					int64validator.Between(scheduleHour.min, scheduleHour.max),
+				},
+			},
+			"at_minute": resourceschema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Minute (0-59) to reboot at.",
+				Validators: []validator.Int64{
+					int64validator.Between(scheduleMinute.min, scheduleMinute.max),
+				},
+			},
+			"deliver_within": resourceschema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Number of hours after the scheduled time within which the reboot must be delivered. Defaults to `1`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"deliver_delay_window": resourceschema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Randomise each computer's reboot within this many minutes so the fleet does not reboot at once. `0` disables randomisation.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"access_group": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Access group to create the profile in.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"all_computers": resourceschema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to apply the profile to all computers.",
+			},
+			"tags": resourceschema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Tags used to target computers.",
+			},
+			"next_run": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the profile next runs. Only recomputed when the schedule changes.",
+			},
+		},
+	}
+}
+
+func (r *RebootProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Reboot profile identifier.")
+}
+
+func (r *RebootProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *RebootProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RebootProfileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCalendarSchedule(config.Every, config.OnDays, config.AtHour)...)
+}
+
+// ModifyPlan keeps next_run from state when the schedule is unchanged, so
+// edits to the title or targeting do not show it as known after apply.
+func (r *RebootProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state RebootProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.sameSchedule(state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_run"), state.NextRun)...)
+	}
+}
+
+// sameSchedule reports whether m and other run at the same times.
+func (m RebootProfileResourceModel) sameSchedule(other RebootProfileResourceModel) bool {
+	return m.Every.Equal(other.Every) &&
+		m.OnDays.Equal(other.OnDays) &&
+		m.AtHour.Equal(other.AtHour) &&
+		m.AtMinute.Equal(other.AtMinute)
+}
+
+func (r *RebootProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RebootProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := planToRebootProfileBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.AccessGroup.IsNull() && !plan.AccessGroup.IsUnknown() {
+		v := plan.AccessGroup.ValueString()
+		body.AccessGroup = &v
+	}
+
+	profile, _, diags := r.send(ctx, http.MethodPost, "/api/rebootprofiles", body, "Failed to create reboot profile")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.Int64Value(int64(profile.Id))
+	plan.NextRun = types.StringPointerValue(profile.NextRun)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})...)
+}
+
+func (r *RebootProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RebootProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, found, diags := r.send(ctx, http.MethodGet, rebootProfileEndpoint(state.Id), nil, "Failed to read reboot profile")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(rebootProfileToState(ctx, profile, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})...)
+}
+
+func (r *RebootProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RebootProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := planToRebootProfileBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, _, diags := r.send(ctx, http.MethodPatch, rebootProfileEndpoint(state.Id), body, "Failed to update reboot profile")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.NextRun = types.StringPointerValue(profile.NextRun)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})...)
+}
+
+func (r *RebootProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RebootProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := doRESTRequest(ctx, r.client, http.MethodDelete, rebootProfileEndpoint(state.Id), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to remove reboot profile", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	if rawResp.StatusCode >= 300 && rawResp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(rawResp.Body)
+		resp.Diagnostics.AddError("Failed to remove reboot profile", restStatusError(rawResp, body))
+	}
+}
+
+func (r *RebootProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateNumericID(ctx, "reboot profile", req, resp)
+}
+
+// send makes a REST call that returns a reboot profile. found is false when
+// the profile does not exist.
+func (r *RebootProfileResource) send(ctx context.Context, method, endpoint string, reqBody any, summary string) (rebootProfile, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var profile rebootProfile
+
+	rawResp, err := doRESTRequest(ctx, r.client, method, endpoint, nil, reqBody)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return profile, false, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode == http.StatusNotFound {
+		return profile, false, diags
+	}
+	if rawResp.StatusCode < 200 || rawResp.StatusCode >= 300 {
+		diags.AddError(summary, restStatusError(rawResp, body))
+		return profile, false, diags
+	}
+
+	if err := json.Unmarshal(body, &profile); err != nil {
+		diags.AddError("Failed to parse reboot profile response", err.Error())
+		return profile, false, diags
+	}
+	return profile, true, diags
+}
+
+func rebootProfileEndpoint(id types.Int64) string {
+	return fmt.Sprintf("/api/rebootprofiles/%d", id.ValueInt64())
+}
+
+func planToRebootProfileBody(ctx context.Context, plan RebootProfileResourceModel) (rebootProfileBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	onDays := []string{}
+	diags.Append(plan.OnDays.ElementsAs(ctx, &onDays, false)...)
+	tags := []string{}
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
+		diags.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	atHour := int(plan.AtHour.ValueInt64())
+	window := int(plan.DeliverDelayWindow.ValueInt64())
+	return rebootProfileBody{
+		Title:              plan.Title.ValueString(),
+		Every:              plan.Every.ValueString(),
+		OnDays:             onDays,
+		AtHour:             &atHour,
+		AtMinute:           int(plan.AtMinute.ValueInt64()),
+		DeliverWithin:      int(plan.DeliverWithin.ValueInt64()),
+		RandomizeDelivery:  window > 0,
+		DeliverDelayWindow: window,
+		AllComputers:       plan.AllComputers.ValueBool(),
+		Tags:               tags,
+	}, diags
+}
+
+func rebootProfileToState(ctx context.Context, profile rebootProfile, state *RebootProfileResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	onDays, d := types.SetValueFrom(ctx, types.StringType, sortedStrings(profile.OnDays))
+	diags.Append(d...)
+	tags, d := types.SetValueFrom(ctx, types.StringType, sortedStrings(profile.Tags))
+	diags.Append(d...)
+
+	state.Id = types.Int64Value(int64(profile.Id))
+	state.Title = types.StringValue(profile.Title)
+	state.Every = types.StringValue(profile.Every)
+	state.OnDays = onDays
+	if profile.AtHour != nil {
+		state.AtHour = types.Int64Value(int64(*profile.AtHour))
+	}
+	state.AtMinute = types.Int64Value(int64(profile.AtMinute))
+	state.DeliverWithin = types.Int64Value(int64(profile.DeliverWithin))
+	state.DeliverDelayWindow = types.Int64Value(int64(profile.DeliverDelayWindow))
+	state.AllComputers = types.BoolValue(profile.AllComputers)
+	state.Tags = tags
+	state.NextRun = types.StringPointerValue(profile.NextRun)
+	if profile.AccessGroup != nil && !state.AccessGroup.IsNull() {
+		state.AccessGroup = types.StringValue(*profile.AccessGroup)
+	}
+	return diags
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRebootProfileResourceMetadata(t *testing.T) {
+	res := NewRebootProfileResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_reboot_profile" {
+		t.Fatalf("expected resource type name landscape_reboot_profile, got %q", resp.TypeName)
+	}
+}
+
+func TestRebootProfileSameSchedule(t *testing.T) {
+	profile := func(title string, day string, hour int64) RebootProfileResourceModel {
+		return RebootProfileResourceModel{
+			Title:    types.StringValue(title),
+			Every:    types.StringValue("week"),
+			OnDays:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue(day)}),
+			AtHour:   types.Int64Value(hour),
+			AtMinute: types.Int64Value(0),
+		}
+	}
+	base := profile("weekly", "mo", 2)
+
+	if !profile("renamed", "mo", 2).sameSchedule(base) {
+		t.Error("expected a title change to keep the schedule")
+	}
+	if profile("weekly", "tu", 2).sameSchedule(base) {
+		t.Error("expected a different day to change the schedule")
+	}
+	if profile("weekly", "mo", 3).sameSchedule(base) {
+		t.Error("expected a different hour to change the schedule")
+	}
+}
+
+func TestAccRebootProfileResourceInvalidDay(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRebootProfileResourceInvalidDayConfig,
+				ExpectError: regexp.MustCompile(`(?i)on_days`),
+			},
+		},
+	})
+}
+
+const testAccRebootProfileResourceInvalidDayConfig = `
+provider "landscape" {}
+
+resource "landscape_reboot_profile" "test" {
+  title     = "weekly"
+  every     = "week"
+  on_days   = ["monday"]
+  at_hour   = 2
+  at_minute = 0
+}
+`
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &RemovalProfileResource{}
+var _ resource.ResourceWithImportState = &RemovalProfileResource{}
+var _ resource.ResourceWithIdentity = &RemovalProfileResource{}
+
+func NewRemovalProfileResource() resource.Resource {
+	return &RemovalProfileResource{}
+}
+
+type RemovalProfileResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type RemovalProfileResourceModel struct {
+	Id                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Title               types.String `tfsdk:"title"`
+	DaysWithoutExchange types.Int64  `tfsdk:"days_without_exchange"`
+	CascadeToChildren   types.Bool   `tfsdk:"cascade_to_children"`
+	AccessGroup         types.String `tfsdk:"access_group"`
+	AllComputers        types.Bool   `tfsdk:"all_computers"`
+	Tags                types.Set    `tfsdk:"tags"`
+}
+
+// legacyRemovalProfile is a removal profile as returned by the legacy
+// GetRemovalProfiles action.
+type legacyRemovalProfile struct {
+	Id                  int      `json:"id"`
+	Name                string   `json:"name"`
+	Title               string   `json:"title"`
+	DaysWithoutExchange int      `json:"days_without_exchange"`
+	AccessGroup         *string  `json:"access_group"`
+	AllComputers        bool     `json:"all_computers"`
+	Tags                []string `json:"tags"`
+}
+
+func (r *RemovalProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_removal_profile"
+}
+
+func (r *RemovalProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Manages a Landscape removal profile, which removes computers that have not contacted Landscape for a number of days.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the removal profile.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"name": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The slug name of the profile returned by the API.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Title for the removal profile.",
+			},
+			"days_without_exchange": resourceschema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Number of days a computer may go without contacting Landscape before it is removed.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cascade_to_children": resourceschema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether child computers (e.g. virtual machines and WSL instances) are removed along with their parent. Changing this forces a new profile.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"access_group": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Access group the profile applies to.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"all_computers": resourceschema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to apply the profile to all computers.",
+			},
+			"tags": resourceschema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Tags used to target computers.",
+			},
+		},
+	}
+}
+
+func (r *RemovalProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Name of the removal profile.")
+}
+
+func (r *RemovalProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *RemovalProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RemovalProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cascade := plan.CascadeToChildren.ValueBool()
+	allComputers := plan.AllComputers.ValueBool()
+	createParams := &landscape.LegacyCreateRemovalProfileParams{
+		Title:               plan.Title.ValueString(),
+		DaysWithoutExchange: int(plan.DaysWithoutExchange.ValueInt64()),
+		CascadeToChildren:   &cascade,
+		AllComputers:        &allComputers,
+	}
+	if len(tags) > 0 {
+		createParams.Tags = &tags
+	}
+	if !plan.AccessGroup.IsNull() && !plan.AccessGroup.IsUnknown() {
+		v := plan.AccessGroup.ValueString()
+		createParams.AccessGroup = &v
+	}
+
+	rawResp, err := r.client.LegacyCreateRemovalProfile(ctx, createParams)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create removal profile", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Failed to create removal profile", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return
+	}
+
+	profile, err := landscape.ParseLegacyResponse[legacyRemovalProfile](body)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse removal profile response", err.Error())
+		return
+	}
+	if profile.Name == "" {
+		resp.Diagnostics.AddError("Failed to parse removal profile response", "response missing 'name' field")
+		return
+	}
+
+	plan.Id = types.Int64Value(int64(profile.Id))
+	plan.Name = types.StringValue(profile.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *RemovalProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RemovalProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, found, diags := fetchRemovalProfile(ctx, r.client, state.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, sortedStrings(profile.Tags))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.Int64Value(int64(profile.Id))
+	state.Title = types.StringValue(profile.Title)
+	state.DaysWithoutExchange = types.Int64Value(int64(profile.DaysWithoutExchange))
+	state.AllComputers = types.BoolValue(profile.AllComputers)
+	state.Tags = tags
+	if profile.AccessGroup != nil && !state.AccessGroup.IsNull() {
+		state.AccessGroup = types.StringValue(*profile.AccessGroup)
+	}
+	// The API does not report cascade_to_children; keep the configured value
+	// and fall back to the default after import.
+	if state.CascadeToChildren.IsNull() {
+		state.CascadeToChildren = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
+}
+
+func (r *RemovalProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RemovalProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileName := state.Name.ValueString()
+	plan.Id = state.Id
+	plan.Name = state.Name
+
+	title := plan.Title.ValueString()
+	days := int(plan.DaysWithoutExchange.ValueInt64())
+	rawResp, err := r.client.LegacyEditRemovalProfile(ctx, &landscape.LegacyEditRemovalProfileParams{
+		Name:                profileName,
+		Title:               &title,
+		DaysWithoutExchange: &days,
+	})
+	resp.Diagnostics.Append(legacyActionDiags("Failed to update removal profile", rawResp, err)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(reconcileProfileTargeting(ctx, plan.Tags, state.Tags, plan.AllComputers, state.AllComputers,
+		r.associate(profileName), r.disassociate(profileName))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *RemovalProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RemovalProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := r.client.LegacyRemoveRemovalProfile(ctx, &landscape.LegacyRemoveRemovalProfileParams{
+		Name: state.Name.ValueString(),
+	})
+	resp.Diagnostics.Append(legacyActionDiags("Failed to remove removal profile", rawResp, err)...)
+}
+
+func (r *RemovalProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+}
+
+func (r *RemovalProfileResource) associate(name string) profileAssociationFunc {
+	return func(ctx context.Context, tags *[]string, allComputers *bool) (*http.Response, error) {
+		return r.client.LegacyAssociateRemovalProfile(ctx, &landscape.LegacyAssociateRemovalProfileParams{
+			Name:         name,
+			Tags:         tags,
+			AllComputers: allComputers,
+		})
+	}
+}
+
+func (r *RemovalProfileResource) disassociate(name string) profileAssociationFunc {
+	return func(ctx context.Context, tags *[]string, allComputers *bool) (*http.Response, error) {
+		return r.client.LegacyDisassociateRemovalProfile(ctx, &landscape.LegacyDisassociateRemovalProfileParams{
+			Name:         name,
+			Tags:         tags,
+			AllComputers: allComputers,
+		})
+	}
+}
+
+// fetchRemovalProfile looks up a removal profile by name.
+func fetchRemovalProfile(ctx context.Context, client *landscape.ClientWithResponses, name string) (legacyRemovalProfile, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawResp, err := client.LegacyGetRemovalProfiles(ctx)
+	if err != nil {
+		diags.AddError("Failed to read removal profile", err.Error())
+		return legacyRemovalProfile{}, false, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read removal profile", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return legacyRemovalProfile{}, false, diags
+	}
+
+	profiles, err := landscape.ParseLegacyResponse[[]legacyRemovalProfile](body)
+	if err != nil {
+		diags.AddError("Failed to parse removal profile response", err.Error())
+		return legacyRemovalProfile{}, false, diags
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, true, diags
+		}
+	}
+	return legacyRemovalProfile{}, false, diags
+}
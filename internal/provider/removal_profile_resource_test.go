@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRemovalProfileResourceMetadata(t *testing.T) {
+	res := NewRemovalProfileResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_removal_profile" {
+		t.Fatalf("expected resource type name landscape_removal_profile, got %q", resp.TypeName)
+	}
+}
+
+func TestAccRemovalProfileResourceInvalidDays(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRemovalProfileResourceInvalidDaysConfig,
+				ExpectError: regexp.MustCompile(`(?i)days_without_exchange`),
+			},
+		},
+	})
+}
+
+const testAccRemovalProfileResourceInvalidDaysConfig = `
+provider "landscape" {}
+
+resource "landscape_removal_profile" "test" {
+  title                 = "stale"
+  days_without_exchange = 0
+}
+`
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ list.ListResource = &RepositoryProfileListResource{}
+var _ list.ListResourceWithConfigure = &RepositoryProfileListResource{}
+
+func NewRepositoryProfileListResource() list.ListResource {
+	return &RepositoryProfileListResource{}
+}
+
+type RepositoryProfileListResource struct {
+	client *landscape.ClientWithResponses
+}
+
+// legacyRepositoryProfile is a repository profile as returned by the legacy
+// GetRepositoryProfiles action.
+type legacyRepositoryProfile struct {
+	Name         string   `json:"name"`
+	Title        string   `json:"title"`
+	Description  *string  `json:"description"`
+	AccessGroup  *string  `json:"access_group"`
+	AllComputers bool     `json:"all_computers"`
+	Tags         []string `json:"tags"`
+}
+
+func (r *RepositoryProfileListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_profile"
+}
+
+func (r *RepositoryProfileListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the repository profiles in the account. Pockets are not listed; set `pockets`, `series` and `distribution` in the generated configuration.",
+	}
+}
+
+func (r *RepositoryProfileListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResource(req, resp)
+}
+
+func (r *RepositoryProfileListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	profiles, diags := fetchRepositoryProfiles(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, profiles, func(p legacyRepositoryProfile, result *list.ListResult) {
+		result.DisplayName = p.Title
+		result.Diagnostics.Append(result.Identity.Set(ctx, nameIdentityModel{Name: types.StringValue(p.Name)})...)
+		if !req.IncludeResource {
+			return
+		}
+
+		tagList := append([]string{}, p.Tags...)
+		sort.Strings(tagList)
+		tags, diags := types.SetValueFrom(ctx, types.StringType, tagList)
+		result.Diagnostics.Append(diags...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, RepositoryProfileResourceModel{
+			Name:         types.StringValue(p.Name),
+			Title:        types.StringValue(p.Title),
+			Description:  types.StringPointerValue(p.Description),
+			AccessGroup:  types.StringPointerValue(p.AccessGroup),
+			Pockets:      types.ListNull(types.StringType),
+			Series:       types.StringNull(),
+			Distribution: types.StringNull(),
+			AllComputers: types.BoolValue(p.AllComputers),
+			Tags:         tags,
+		})...)
+	})
+}
+
+// fetchRepositoryProfiles returns every repository profile in the account.
+func fetchRepositoryProfiles(ctx context.Context, client *landscape.ClientWithResponses) ([]legacyRepositoryProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawResp, err := client.LegacyGetRepositoryProfiles(ctx, &landscape.LegacyGetRepositoryProfilesParams{})
+	if err != nil {
+		diags.AddError("Failed to read repository profiles", err.Error())
+		return nil, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read repository profiles", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return nil, diags
+	}
+
+	profiles, err := landscape.ParseLegacyResponse[[]legacyRepositoryProfile](body)
+	if err != nil {
+		diags.AddError("Failed to parse repository profiles response", err.Error())
+		return nil, diags
+	}
+	return profiles, diags
+}
@@ -21,6 +21,7 @@ import (
 
 var _ resource.Resource = &RepositoryProfileResource{}
 var _ resource.ResourceWithImportState = &RepositoryProfileResource{}
+var _ resource.ResourceWithIdentity = &RepositoryProfileResource{}
 
 func NewRepositoryProfileResource() resource.Resource {
 	return &RepositoryProfileResource{}
@@ -99,6 +100,10 @@ func (r *RepositoryProfileResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *RepositoryProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Slug name of the repository profile.")
+}
+
 func (r *RepositoryProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -212,6 +217,7 @@ func (r *RepositoryProfileResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
 }
 
 func (r *RepositoryProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -244,6 +250,7 @@ func (r *RepositoryProfileResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
 }
 
 func (r *RepositoryProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -277,6 +284,7 @@ func (r *RepositoryProfileResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
 }
 
 func (r *RepositoryProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -301,5 +309,5 @@ func (r *RepositoryProfileResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *RepositoryProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
 }
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+// doRESTRequest sends a request to a Landscape REST endpoint that the generated
+// client does not expose yet. It reuses the client's server URL, HTTP doer and
+// request editors so authentication and TLS settings carry over unchanged.
+func doRESTRequest(ctx context.Context, client *landscape.ClientWithResponses, method, endpoint string, query url.Values, body any) (*http.Response, error) {
+	raw, ok := client.ClientInterface.(*landscape.Client)
+	if !ok {
+		return nil, fmt.Errorf("unexpected Landscape client type %T", client.ClientInterface)
+	}
+
+	serverURL, err := url.Parse(raw.Server)
+	if err != nil {
+		return nil, err
+	}
+	reqURL, err := serverURL.Parse("." + endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if len(query) > 0 {
+		reqURL.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, edit := range raw.RequestEditors {
+		if err := edit(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return raw.Client.Do(req)
+}
+
+// restStatusError formats a non-success REST response the same way the raw
+// legacy calls do.
+func restStatusError(rawResp *http.Response, body []byte) string {
+	return fmt.Sprintf("status %s: %s", rawResp.Status, body)
+}
+
+// legacyActionDiags turns the result of a raw legacy action call whose
+// response body is not needed into diagnostics, closing the body.
+func legacyActionDiags(summary string, rawResp *http.Response, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+	defer rawResp.Body.Close()
+	if rawResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(rawResp.Body)
+		diags.AddError(summary, fmt.Sprintf("status %s: %s", rawResp.Status, body))
+	}
+	return diags
+}
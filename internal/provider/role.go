@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+// legacyRole is a role as returned by the legacy GetRoles action.
+type legacyRole struct {
+	Name         string   `json:"name"`
+	Description  *string  `json:"description"`
+	Permissions  []string `json:"permissions"`
+	AccessGroups []string `json:"access_groups"`
+	Persons      []string `json:"persons"`
+}
+
+// legacyPermission is a permission as returned by the legacy GetPermissions
+// action.
+type legacyPermission struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// fetchRole looks up a role by name. found is false when no role has that
+// name.
+func fetchRole(ctx context.Context, client *landscape.ClientWithResponses, name string) (legacyRole, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := []string{name}
+	rawResp, err := client.LegacyGetRoles(ctx, &landscape.LegacyGetRolesParams{
+		Names: &names,
+	})
+	if err != nil {
+		diags.AddError("Failed to read role", err.Error())
+		return legacyRole{}, false, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read role", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return legacyRole{}, false, diags
+	}
+
+	roles, err := landscape.ParseLegacyResponse[[]legacyRole](body)
+	if err != nil {
+		diags.AddError("Failed to parse role response", err.Error())
+		return legacyRole{}, false, diags
+	}
+	for _, role := range roles {
+		if role.Name == name {
+			return role, true, diags
+		}
+	}
+	return legacyRole{}, false, diags
+}
+
+// fetchPermissions returns every permission that can be granted to a role.
+func fetchPermissions(ctx context.Context, client *landscape.ClientWithResponses) ([]legacyPermission, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawResp, err := client.LegacyGetPermissions(ctx)
+	if err != nil {
+		diags.AddError("Failed to read permissions", err.Error())
+		return nil, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read permissions", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return nil, diags
+	}
+
+	permissions, err := landscape.ParseLegacyResponse[[]legacyPermission](body)
+	if err != nil {
+		diags.AddError("Failed to parse permissions response", err.Error())
+		return nil, diags
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i].Name < permissions[j].Name })
+	return permissions, diags
+}
+
+// stringsMissingFrom returns the values of a that are not in b, sorted.
+func stringsMissingFrom(a, b []string) []string {
+	in := map[string]bool{}
+	for _, v := range b {
+		in[v] = true
+	}
+	missing := []string{}
+	for _, v := range a {
+		if !in[v] {
+			missing = append(missing, v)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// stringsPresentIn returns the values of a that are also in b, sorted.
+func stringsPresentIn(a, b []string) []string {
+	in := map[string]bool{}
+	for _, v := range b {
+		in[v] = true
+	}
+	present := []string{}
+	for _, v := range a {
+		if in[v] {
+			present = append(present, v)
+		}
+	}
+	sort.Strings(present)
+	return present
+}
+
+// sortedStrings returns a sorted, non-nil copy of s.
+func sortedStrings(s []string) []string {
+	sorted := append([]string{}, s...)
+	sort.Strings(sorted)
+	return sorted
+}
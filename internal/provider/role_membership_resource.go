@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &RoleMembershipResource{}
+var _ resource.ResourceWithImportState = &RoleMembershipResource{}
+var _ resource.ResourceWithIdentity = &RoleMembershipResource{}
+
+func NewRoleMembershipResource() resource.Resource {
+	return &RoleMembershipResource{}
+}
+
+type RoleMembershipResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type RoleMembershipResourceModel struct {
+	Role    types.String `tfsdk:"role"`
+	Persons types.Set    `tfsdk:"persons"`
+}
+
+// roleMembershipIdentityModel identifies a membership by its role.
+type roleMembershipIdentityModel struct {
+	Role types.String `tfsdk:"role"`
+}
+
+func (r *RoleMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_membership"
+}
+
+func (r *RoleMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Assigns administrators to a role. Only the listed administrators are managed; others holding the role are left alone.",
+		Attributes: map[string]resourceschema.Attribute{
+			"role": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"persons": resourceschema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Email addresses of the administrators to assign to the role.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"role": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the role whose members are managed.",
+			},
+		},
+	}
+}
+
+func (r *RoleMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *RoleMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var persons []string
+	resp.Diagnostics.Append(plan.Persons.ElementsAs(ctx, &persons, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := r.client.LegacyAddPersonsToRole(ctx, &landscape.LegacyAddPersonsToRoleParams{
+		Name:    plan.Role.ValueString(),
+		Persons: persons,
+	})
+	resp.Diagnostics.Append(legacyActionDiags("Failed to add persons to role", rawResp, err)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, roleMembershipIdentityModel{Role: plan.Role})...)
+}
+
+func (r *RoleMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, found, diags := fetchRole(ctx, r.client, state.Role.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// After import nothing is managed yet, so adopt every current member.
+	// Otherwise keep only the managed members that still hold the role.
+	members := sortedStrings(role.Persons)
+	if !state.Persons.IsNull() {
+		var managed []string
+		resp.Diagnostics.Append(state.Persons.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		members = stringsPresentIn(managed, role.Persons)
+	}
+	if len(members) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	persons, diags := types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Persons = persons
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, roleMembershipIdentityModel{Role: state.Role})...)
+}
+
+func (r *RoleMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RoleMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var want, have []string
+	resp.Diagnostics.Append(plan.Persons.ElementsAs(ctx, &want, false)...)
+	resp.Diagnostics.Append(state.Persons.ElementsAs(ctx, &have, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if add := stringsMissingFrom(want, have); len(add) > 0 {
+		rawResp, err := r.client.LegacyAddPersonsToRole(ctx, &landscape.LegacyAddPersonsToRoleParams{Name: plan.Role.ValueString(), Persons: add})
+		resp.Diagnostics.Append(legacyActionDiags("Failed to add persons to role", rawResp, err)...)
+	}
+	if remove := stringsMissingFrom(have, want); len(remove) > 0 {
+		rawResp, err := r.client.LegacyRemovePersonsFromRole(ctx, &landscape.LegacyRemovePersonsFromRoleParams{Name: plan.Role.ValueString(), Persons: remove})
+		resp.Diagnostics.Append(legacyActionDiags("Failed to remove persons from role", rawResp, err)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, roleMembershipIdentityModel{Role: plan.Role})...)
+}
+
+func (r *RoleMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var persons []string
+	resp.Diagnostics.Append(state.Persons.ElementsAs(ctx, &persons, false)...)
+	if resp.Diagnostics.HasError() || len(persons) == 0 {
+		return
+	}
+
+	rawResp, err := r.client.LegacyRemovePersonsFromRole(ctx, &landscape.LegacyRemovePersonsFromRoleParams{
+		Name:    state.Role.ValueString(),
+		Persons: persons,
+	})
+	resp.Diagnostics.Append(legacyActionDiags("Failed to remove persons from role", rawResp, err)...)
+}
+
+// ImportState takes the role name, or a `role` identity, and adopts all of
+// its current members.
+func (r *RoleMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("role"), path.Root("role"), req, resp)
+}
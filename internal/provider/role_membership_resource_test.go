@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRoleMembershipResourceMetadata(t *testing.T) {
+	res := NewRoleMembershipResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_role_membership" {
+		t.Fatalf("expected resource type name landscape_role_membership, got %q", resp.TypeName)
+	}
+}
+
+func TestAccRoleMembershipResourceEmptyPersons(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleMembershipResourceEmptyPersonsConfig,
+				ExpectError: regexp.MustCompile(`(?i)at least 1`),
+			},
+		},
+	})
+}
+
+const testAccRoleMembershipResourceEmptyPersonsConfig = `
+provider "landscape" {}
+
+resource "landscape_role_membership" "test" {
+  role    = "script-runners"
+  persons = []
+}
+`
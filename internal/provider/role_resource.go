@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &RoleResource{}
+var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithIdentity = &RoleResource{}
+var _ resource.ResourceWithModifyPlan = &RoleResource{}
+
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+type RoleResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type RoleResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Permissions  types.Set    `tfsdk:"permissions"`
+	AccessGroups types.Set    `tfsdk:"access_groups"`
+}
+
+func (r *RoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptySet := types.SetValueMust(types.StringType, []attr.Value{})
+
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Manages a custom Landscape role: the permissions it grants and the access groups they apply to. " +
+			"Assign administrators to it with `landscape_role_membership`.",
+		Attributes: map[string]resourceschema.Attribute{
+			"name": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique name of the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": resourceschema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(emptySet),
+				MarkdownDescription: "Permissions granted by the role, e.g. `ViewComputer` or `ExecuteScript`. Names are checked against `landscape_permissions` at plan time.",
+			},
+			"access_groups": resourceschema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(emptySet),
+				MarkdownDescription: "Names of the access groups the permissions apply to.",
+			},
+		},
+	}
+}
+
+func (r *RoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Name of the role.")
+}
+
+func (r *RoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan rejects unknown permission names before anything is applied.
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Permissions.IsUnknown() {
+		return
+	}
+
+	var wanted []string
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &wanted, false)...)
+	if resp.Diagnostics.HasError() || len(wanted) == 0 {
+		return
+	}
+
+	permissions, diags := fetchPermissions(ctx, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valid := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		valid = append(valid, p.Name)
+	}
+	if unknown := stringsMissingFrom(wanted, valid); len(unknown) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permissions"),
+			"Unknown permissions",
+			fmt.Sprintf("Landscape does not know the permissions %s. Valid permissions are: %s.", strings.Join(unknown, ", "), strings.Join(valid, ", ")),
+		)
+	}
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createParams := &landscape.LegacyCreateRoleParams{
+		Name: plan.Name.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		v := plan.Description.ValueString()
+		createParams.Description = &v
+	}
+
+	rawResp, err := r.client.LegacyCreateRole(ctx, createParams)
+	resp.Diagnostics.Append(legacyActionDiags("Failed to create role", rawResp, err)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The role exists from here on; save it even if granting fails so it is
+	// tracked (and tainted) rather than orphaned.
+	empty := RoleResourceModel{
+		Permissions:  types.SetValueMust(types.StringType, []attr.Value{}),
+		AccessGroups: types.SetValueMust(types.StringType, []attr.Value{}),
+	}
+	diags := r.reconcile(ctx, plan, empty)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, found, diags := fetchRole(ctx, r.client, state.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if role.Description != nil && *role.Description != "" {
+		state.Description = types.StringValue(*role.Description)
+	} else if !state.Description.IsNull() {
+		state.Description = types.StringValue("")
+	}
+
+	permissions, diags := types.SetValueFrom(ctx, types.StringType, sortedStrings(role.Permissions))
+	resp.Diagnostics.Append(diags...)
+	accessGroups, diags := types.SetValueFrom(ctx, types.StringType, sortedStrings(role.AccessGroups))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Permissions = permissions
+	state.AccessGroups = accessGroups
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := r.client.LegacyRemoveRole(ctx, &landscape.LegacyRemoveRoleParams{
+		Name: state.Name.ValueString(),
+	})
+	resp.Diagnostics.Append(legacyActionDiags("Failed to remove role", rawResp, err)...)
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+}
+
+// reconcile grants and revokes permissions and access groups so the role
+// matches plan, given what prior currently holds.
+func (r *RoleResource) reconcile(ctx context.Context, plan, prior RoleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	name := plan.Name.ValueString()
+
+	var wantPerms, havePerms, wantGroups, haveGroups []string
+	diags.Append(plan.Permissions.ElementsAs(ctx, &wantPerms, false)...)
+	diags.Append(prior.Permissions.ElementsAs(ctx, &havePerms, false)...)
+	diags.Append(plan.AccessGroups.ElementsAs(ctx, &wantGroups, false)...)
+	diags.Append(prior.AccessGroups.ElementsAs(ctx, &haveGroups, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if add := stringsMissingFrom(wantPerms, havePerms); len(add) > 0 {
+		rawResp, err := r.client.LegacyAddPermissionsToRole(ctx, &landscape.LegacyAddPermissionsToRoleParams{Name: name, Permissions: add})
+		diags.Append(legacyActionDiags("Failed to add permissions to role", rawResp, err)...)
+	}
+	if remove := stringsMissingFrom(havePerms, wantPerms); len(remove) > 0 {
+		rawResp, err := r.client.LegacyRemovePermissionsFromRole(ctx, &landscape.LegacyRemovePermissionsFromRoleParams{Name: name, Permissions: remove})
+		diags.Append(legacyActionDiags("Failed to remove permissions from role", rawResp, err)...)
+	}
+	if add := stringsMissingFrom(wantGroups, haveGroups); len(add) > 0 {
+		rawResp, err := r.client.LegacyAddAccessGroupsToRole(ctx, &landscape.LegacyAddAccessGroupsToRoleParams{Name: name, AccessGroups: add})
+		diags.Append(legacyActionDiags("Failed to add access groups to role", rawResp, err)...)
+	}
+	if remove := stringsMissingFrom(haveGroups, wantGroups); len(remove) > 0 {
+		rawResp, err := r.client.LegacyRemoveAccessGroupsFromRole(ctx, &landscape.LegacyRemoveAccessGroupsFromRoleParams{Name: name, AccessGroups: remove})
+		diags.Append(legacyActionDiags("Failed to remove access groups from role", rawResp, err)...)
+	}
+	return diags
+}
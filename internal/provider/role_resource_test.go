@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRoleResourceMetadata(t *testing.T) {
+	res := NewRoleResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_role" {
+		t.Fatalf("expected resource type name landscape_role, got %q", resp.TypeName)
+	}
+}
+
+func TestStringSetDifferences(t *testing.T) {
+	have := []string{"ViewComputer", "ManageComputer"}
+	want := []string{"ExecuteScript", "ViewComputer"}
+
+	if got := stringsMissingFrom(want, have); !reflect.DeepEqual(got, []string{"ExecuteScript"}) {
+		t.Fatalf("expected ExecuteScript to be added, got %v", got)
+	}
+	if got := stringsMissingFrom(have, want); !reflect.DeepEqual(got, []string{"ManageComputer"}) {
+		t.Fatalf("expected ManageComputer to be removed, got %v", got)
+	}
+	if got := stringsPresentIn(have, want); !reflect.DeepEqual(got, []string{"ViewComputer"}) {
+		t.Fatalf("expected ViewComputer to be kept, got %v", got)
+	}
+}
+
+func TestAccRoleResourceMissingName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleResourceMissingNameConfig,
+				ExpectError: regexp.MustCompile(`(?i)name`),
+			},
+		},
+	})
+}
+
+const testAccRoleResourceMissingNameConfig = `
+provider "landscape" {}
+
+resource "landscape_role" "test" {
+  permissions = ["ViewComputer"]
+}
+`
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scheduleField is the range of values one field of a cron expression may
+// take. The minute and hour bounds also apply to the at_minute and at_hour
+// attributes of the legacy calendar-style profiles.
+type scheduleField struct {
+	name     string
+	min, max int64
+}
+
+var (
+	scheduleMinute = scheduleField{name: "minute", min: 0, max: 59}
+	scheduleHour   = scheduleField{name: "hour", min: 0, max: 23}
+)
+
+// scheduleWeekdays are the day names accepted by the on_days attribute of
+// legacy profiles.
+var scheduleWeekdays = []string{"mo", "tu", "we", "th", "fr", "sa", "su"}
+
+// parseScheduleTimestamp parses an RFC3339 schedule time, reporting errors
+// against the named attribute.
+func parseScheduleTimestamp(attr, value string) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ts, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid %s", attr), fmt.Sprintf("Must be RFC3339: %s", err))
+	}
+	return ts, diags
+}
+
+// keepSameInstant returns prior when it denotes the same instant as the
+// RFC3339 time observed from the API, so a different offset or precision is
+// not reported as drift, and observed otherwise. prior is read as by
+// parseTriggerTime, so with a timezone it may be a local datetime.
+func keepSameInstant(prior types.String, timezone string, observed types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() || observed.IsNull() || observed.IsUnknown() {
+		return observed
+	}
+	want, err := time.Parse(time.RFC3339, observed.ValueString())
+	if err != nil {
+		return observed
+	}
+	if ts, diags := parseTriggerTime("", prior.ValueString(), timezone); !diags.HasError() && ts.Equal(want) {
+		return prior
+	}
+	return observed
+}
+
+// validateCalendarSchedule checks the every/on_days/at_hour attributes shared
+// by the legacy upgrade and reboot profiles. Hourly schedules run at
+// at_minute past every hour; any other frequency needs the days and hour to
+// run on. Unknown values are skipped so it can run from ValidateConfig.
+func validateCalendarSchedule(every types.String, onDays types.Set, atHour types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if every.IsNull() || every.IsUnknown() {
+		return diags
+	}
+
+	if every.ValueString() == "hour" {
+		if !atHour.IsNull() && !atHour.IsUnknown() {
+			diags.AddAttributeError(path.Root("at_hour"), "Invalid schedule",
+				"`at_hour` cannot be set when `every` is `hour`; the profile runs at `at_minute` past every hour.")
+		}
+		return diags
+	}
+
+	if onDays.IsNull() || (!onDays.IsUnknown() && len(onDays.Elements()) == 0) {
+		diags.AddAttributeError(path.Root("on_days"), "Invalid schedule",
+			fmt.Sprintf("`on_days` must list at least one day when `every` is `%s`.", every.ValueString()))
+	}
+	if atHour.IsNull() {
+		diags.AddAttributeError(path.Root("at_hour"), "Invalid schedule",
+			fmt.Sprintf("`at_hour` is required when `every` is `%s`.", every.ValueString()))
+	}
+	return diags
+}
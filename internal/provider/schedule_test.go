@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateCalendarSchedule(t *testing.T) {
+	days := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("mo")})
+	noDays := types.SetNull(types.StringType)
+
+	cases := []struct {
+		name    string
+		every   string
+		onDays  types.Set
+		atHour  types.Int64
+		wantErr bool
+	}{
+		{"hourly", "hour", noDays, types.Int64Null(), false},
+		{"hourly with hour", "hour", noDays, types.Int64Value(3), true},
+		{"weekly", "week", days, types.Int64Value(3), false},
+		{"weekly without days", "week", noDays, types.Int64Value(3), true},
+		{"weekly with empty days", "week", types.SetValueMust(types.StringType, nil), types.Int64Value(3), true},
+		{"weekly without hour", "week", days, types.Int64Null(), true},
+		{"weekly with unknown days", "week", types.SetUnknown(types.StringType), types.Int64Value(3), false},
+	}
+	for _, tc := range cases {
+		diags := validateCalendarSchedule(types.StringValue(tc.every), tc.onDays, tc.atHour)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tc.name, tc.wantErr, diags)
+		}
+	}
+}
+
+func TestKeepSameInstant(t *testing.T) {
+	cases := []struct {
+		name     string
+		prior    types.String
+		timezone string
+		observed types.String
+		want     types.String
+	}{
+		{"other offset kept", types.StringValue("2026-01-01T01:00:00+01:00"), "", types.StringValue("2026-01-01T00:00:00Z"), types.StringValue("2026-01-01T01:00:00+01:00")},
+		{"local datetime kept", types.StringValue("2026-01-01T09:00:00"), "Europe/Berlin", types.StringValue("2026-01-01T08:00:00Z"), types.StringValue("2026-01-01T09:00:00")},
+		{"changed instant", types.StringValue("2026-01-01T00:00:00Z"), "", types.StringValue("2026-01-02T00:00:00Z"), types.StringValue("2026-01-02T00:00:00Z")},
+		{"null prior", types.StringNull(), "", types.StringValue("2026-01-02T00:00:00Z"), types.StringValue("2026-01-02T00:00:00Z")},
+		{"cleared", types.StringValue("2026-01-01T00:00:00Z"), "", types.StringNull(), types.StringNull()},
+	}
+	for _, tc := range cases {
+		if got := keepSameInstant(tc.prior, tc.timezone, tc.observed); !got.Equal(tc.want) {
+			t.Errorf("%s: expected %s, got %s", tc.name, tc.want, got)
+		}
+	}
+}
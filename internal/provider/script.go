@@ -5,10 +5,18 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	landscape "github.com/jansdhillon/landscape-go-api-client/client"
 )
@@ -34,6 +42,12 @@ var scriptAttachmentAttrType = map[string]attr.Type{
 	"filename": types.StringType,
 }
 
+var scriptInlineAttachmentAttrType = map[string]attr.Type{
+	"filename": types.StringType,
+	"content":  types.StringType,
+	"source":   types.StringType,
+}
+
 func fetchV1Code(ctx context.Context, client *landscape.ClientWithResponses, id int) (string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -64,3 +78,264 @@ func fetchV1Code(ctx context.Context, client *landscape.ClientWithResponses, id
 
 	return raw, diags
 }
+
+// sha256Hex returns the hex-encoded SHA-256 digest of b.
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// fetchV2Script reads a script by ID and converts it to a V2 script.
+func fetchV2Script(ctx context.Context, client *landscape.ClientWithResponses, id int64) (landscape.V2Script, diag.Diagnostics) {
+	v2Script, found, diags := lookupV2Script(ctx, client, id)
+	if !found && !diags.HasError() {
+		diags.AddError("Failed to read script", fmt.Sprintf("Script %d not found", id))
+	}
+	return v2Script, diags
+}
+
+// lookupV2Script is like fetchV2Script but reports a missing script through
+// found instead of an error, so callers can drop it from state.
+func lookupV2Script(ctx context.Context, client *landscape.ClientWithResponses, id int64) (landscape.V2Script, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scriptRes, err := client.GetScriptWithResponse(ctx, landscape.ScriptIdPathParam(id))
+	if err != nil {
+		diags.AddError("Failed to read script", err.Error())
+		return landscape.V2Script{}, false, diags
+	}
+	if scriptRes.StatusCode() == http.StatusNotFound {
+		return landscape.V2Script{}, false, diags
+	}
+	if scriptRes.JSON200 == nil {
+		diags.AddError("Failed to read script", fmt.Sprintf("Error getting script: %s", scriptRes.Status()))
+		return landscape.V2Script{}, false, diags
+	}
+
+	v2Script, err := scriptRes.JSON200.AsV2Script()
+	if err != nil {
+		diags.AddError("Script is not a V2 script", "Attachments are only supported on V2 scripts.")
+		return landscape.V2Script{}, false, diags
+	}
+	return v2Script, true, diags
+}
+
+// fetchV1Script reads a V1 script by ID together with its code.
+func fetchV1Script(ctx context.Context, client *landscape.ClientWithResponses, id int64) (landscape.V1Script, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scriptRes, err := client.GetScriptWithResponse(ctx, landscape.ScriptIdPathParam(id))
+	if err != nil {
+		diags.AddError("Failed to read script", err.Error())
+		return landscape.V1Script{}, "", diags
+	}
+	if scriptRes.JSON200 == nil {
+		diags.AddError("Failed to read script", fmt.Sprintf("Error getting script: %s", scriptRes.Status()))
+		return landscape.V1Script{}, "", diags
+	}
+
+	v1Script, err := scriptRes.JSON200.AsV1Script()
+	if err != nil {
+		diags.AddError("Failed to read script", fmt.Sprintf("Script %d is not a V1 script.", id))
+		return landscape.V1Script{}, "", diags
+	}
+
+	code, diags := fetchV1Code(ctx, client, v1Script.Id)
+	return v1Script, code, diags
+}
+
+// v2ScriptAttachmentIDs maps attachment filenames to their IDs.
+func v2ScriptAttachmentIDs(v2Script landscape.V2Script) map[string]int64 {
+	ids := map[string]int64{}
+	if v2Script.Attachments != nil {
+		for _, att := range *v2Script.Attachments {
+			ids[att.Filename] = int64(att.Id)
+		}
+	}
+	return ids
+}
+
+// createV2ScriptAttachment uploads content as a new attachment on a V2 script.
+func createV2ScriptAttachment(ctx context.Context, client *landscape.ClientWithResponses, scriptID int64, filename string, content []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fileParam := fmt.Sprintf("%s$$%s", filename, base64.StdEncoding.EncodeToString(content))
+
+	// The API returns a plain JSON string (the filename), not an object, so
+	// using WithResponse would fail to unmarshal. Use the raw HTTP call instead.
+	rawCreateResp, err := client.LegacyCreateScriptAttachment(ctx, &landscape.LegacyCreateScriptAttachmentParams{
+		ScriptId: int(scriptID),
+		File:     fileParam,
+	})
+	if err != nil {
+		diags.AddError("Failed to create attachment", err.Error())
+		return diags
+	}
+	defer rawCreateResp.Body.Close()
+	if rawCreateResp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(rawCreateResp.Body)
+		diags.AddError("Failed to create attachment", fmt.Sprintf("status %s: %s", rawCreateResp.Status, errBody))
+	}
+	return diags
+}
+
+// removeV2ScriptAttachment removes the attachment with the given filename from
+// a V2 script.
+func removeV2ScriptAttachment(ctx context.Context, client *landscape.ClientWithResponses, scriptID int64, filename string) diag.Diagnostics {
+	rawResp, err := client.LegacyRemoveScriptAttachment(ctx, &landscape.LegacyRemoveScriptAttachmentParams{
+		ScriptId: int(scriptID),
+		Filename: filename,
+	})
+	return legacyActionDiags("Failed to remove attachment", rawResp, err)
+}
+
+// fetchV2ScriptAttachmentContent returns the raw body of a script attachment.
+// Attachments are returned as plain bytes rather than JSON.
+func fetchV2ScriptAttachmentContent(ctx context.Context, client *landscape.ClientWithResponses, scriptID int64, attachmentID int64) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attachmentContent, err := client.GetScriptAttachmentWithResponse(ctx, int(scriptID), int(attachmentID))
+	if err != nil {
+		diags.AddError("Failed to read script attachment content", err.Error())
+		return nil, diags
+	}
+
+	if attachmentContent.JSON404 != nil {
+		diags.AddError("Attachment not found", *attachmentContent.JSON404.Message)
+		return nil, diags
+	}
+
+	if attachmentContent.StatusCode() != http.StatusOK {
+		diags.AddError("Error reading attachment", fmt.Sprintf("%s\n%s", attachmentContent.Status(), string(attachmentContent.Body)))
+		return nil, diags
+	}
+	return attachmentContent.Body, diags
+}
+
+// Script statuses. V1 scripts always report scriptStatusV1; V2 scripts report
+// one of the other three.
+const (
+	scriptStatusV1       = "V1"
+	scriptStatusActive   = "ACTIVE"
+	scriptStatusArchived = "ARCHIVED"
+	scriptStatusRedacted = "REDACTED"
+)
+
+const scriptPageSize = 1000
+
+// legacyScriptSummary is a script as returned by the legacy GetScripts
+// action. V1 scripts describe their author through Creator and V2 scripts
+// through CreatedBy.
+type legacyScriptSummary struct {
+	Id          int                 `json:"id"`
+	Title       string              `json:"title"`
+	AccessGroup *string             `json:"access_group"`
+	Status      string              `json:"status"`
+	Username    *string             `json:"username"`
+	TimeLimit   *int                `json:"time_limit"`
+	Creator     *legacyScriptAuthor `json:"creator"`
+	CreatedBy   *legacyScriptAuthor `json:"created_by"`
+}
+
+// legacyScriptAuthor is the Landscape user who created a script. Email is
+// only reported for V1 scripts.
+type legacyScriptAuthor struct {
+	Id    *int    `json:"id"`
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+}
+
+// isV1 reports whether the script is a legacy V1 script.
+func (s legacyScriptSummary) isV1() bool {
+	return s.Status == scriptStatusV1
+}
+
+// creatorName returns the name of whoever created the script, or "" when
+// Landscape does not report one.
+func (s legacyScriptSummary) creatorName() string {
+	switch {
+	case s.Creator != nil && s.Creator.Name != nil:
+		return *s.Creator.Name
+	case s.CreatedBy != nil && s.CreatedBy.Name != nil:
+		return *s.CreatedBy.Name
+	}
+	return ""
+}
+
+// creatorEmail returns the creator's email address. Only V1 scripts report
+// one.
+func (s legacyScriptSummary) creatorEmail() string {
+	if s.Creator != nil && s.Creator.Email != nil {
+		return *s.Creator.Email
+	}
+	return ""
+}
+
+// fetchScripts returns every V1 and V2 script in the account, including
+// archived and redacted ones, following the legacy API's limit/offset
+// pagination.
+func fetchScripts(ctx context.Context, client *landscape.ClientWithResponses) ([]legacyScriptSummary, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var scripts []legacyScriptSummary
+
+	scriptType := "all"
+	limit := scriptPageSize
+	for offset := 0; ; offset += limit {
+		rawResp, err := client.LegacyGetScripts(ctx, &landscape.LegacyGetScriptsParams{
+			Limit:      &limit,
+			Offset:     &offset,
+			ScriptType: &scriptType,
+		})
+		if err != nil {
+			diags.AddError("Failed to read scripts", err.Error())
+			return nil, diags
+		}
+		body, _ := io.ReadAll(rawResp.Body)
+		rawResp.Body.Close()
+		if rawResp.StatusCode != http.StatusOK {
+			diags.AddError("Failed to read scripts", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+			return nil, diags
+		}
+
+		page, err := landscape.ParseLegacyResponse[[]legacyScriptSummary](body)
+		if err != nil {
+			diags.AddError("Failed to parse scripts response", err.Error())
+			return nil, diags
+		}
+		scripts = append(scripts, page...)
+		if len(page) < limit {
+			return scripts, diags
+		}
+	}
+}
+
+// findScriptIDByTitle resolves the ID of the single script whose title is
+// exactly title and for which keep returns true. It errors when no script or
+// more than one script matches, since picking one would be a guess.
+func findScriptIDByTitle(ctx context.Context, client *landscape.ClientWithResponses, title string, keep func(legacyScriptSummary) bool) (int64, diag.Diagnostics) {
+	scripts, diags := fetchScripts(ctx, client)
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	var ids []string
+	var match int64
+	for _, s := range scripts {
+		if s.Title != title || !keep(s) {
+			continue
+		}
+		match = int64(s.Id)
+		ids = append(ids, strconv.Itoa(s.Id))
+	}
+
+	switch len(ids) {
+	case 0:
+		diags.AddAttributeError(path.Root("title"), "Script not found", fmt.Sprintf("No script is titled %q.", title))
+	case 1:
+		return match, diags
+	default:
+		diags.AddAttributeError(path.Root("title"), "Ambiguous script title",
+			fmt.Sprintf("%d scripts are titled %q (IDs %s). Set `id` instead.", len(ids), title, strings.Join(ids, ", ")))
+	}
+	return 0, diags
+}
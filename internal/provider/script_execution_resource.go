@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &ScriptExecutionResource{}
+var _ resource.ResourceWithIdentity = &ScriptExecutionResource{}
+
+// scriptExecutionPollInterval is how often Create checks whether the
+// per-computer activities have finished.
+var scriptExecutionPollInterval = 5 * time.Second
+
+func NewScriptExecutionResource() resource.Resource {
+	return &ScriptExecutionResource{}
+}
+
+type ScriptExecutionResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ScriptExecutionResourceModel struct {
+	Id               types.Int64   `tfsdk:"id"`
+	ScriptId         types.Int64   `tfsdk:"script_id"`
+	Query            types.String  `tfsdk:"query"`
+	Tag              types.String  `tfsdk:"tag"`
+	ComputerIds      types.Set     `tfsdk:"computer_ids"`
+	Username         types.String  `tfsdk:"username"`
+	TimeLimit        types.Int64   `tfsdk:"time_limit"`
+	SuccessThreshold types.Float64 `tfsdk:"success_threshold"`
+	WaitTimeout      types.Int64   `tfsdk:"wait_timeout"`
+	Triggers         types.Map     `tfsdk:"triggers"`
+	Succeeded        types.Int64   `tfsdk:"succeeded"`
+	Failed           types.Int64   `tfsdk:"failed"`
+	Results          types.List    `tfsdk:"results"`
+}
+
+var scriptExecutionResultAttrTypes = map[string]attr.Type{
+	"computer_id": types.Int64Type,
+	"status":      types.StringType,
+	"exit_code":   types.Int64Type,
+	"output":      types.StringType,
+}
+
+func (r *ScriptExecutionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script_execution"
+}
+
+func (r *ScriptExecutionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	targetPaths := []path.Expression{
+		path.MatchRoot("query"),
+		path.MatchRoot("tag"),
+		path.MatchRoot("computer_ids"),
+	}
+
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Runs a script once on a set of computers, waits for every computer to finish and records the results. " +
+			"The apply fails when fewer computers than `success_threshold` succeed. Change `triggers` (or any other input) to run the script again; " +
+			"destroying the resource only removes it from state.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the activity that started the execution.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"script_id": resourceschema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the script to run.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"query": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Landscape search query selecting the computers to run on. Exactly one of `query`, `tag` or `computer_ids` must be set.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(targetPaths...),
+				},
+			},
+			"tag": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Run on every computer with this tag.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"computer_ids": resourceschema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Run on these computers.",
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"username": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "User to run the script as. Required when the script has no default username.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"time_limit": resourceschema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				MarkdownDescription: "Seconds the script may run on each computer before it is killed. Defaults to `300`.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"success_threshold": resourceschema.Float64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(1),
+				MarkdownDescription: "Fraction (0-1) of the targeted computers that must succeed for the apply to succeed. Defaults to `1`, every computer.",
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"wait_timeout": resourceschema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1800),
+				MarkdownDescription: "Seconds to wait for every computer to finish before failing the apply. Computers that have not reported yet (e.g. offline ones) count as not succeeded. Defaults to `1800`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"triggers": resourceschema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that run the script again when they change.",
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+			"succeeded": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of computers on which the script succeeded.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"failed": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of computers on which the script failed, was canceled or did not finish in time.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"results": resourceschema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Per-computer results, ordered by computer ID.",
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: resourceschema.NestedAttributeObject{
+					Attributes: map[string]resourceschema.Attribute{
+						"computer_id": resourceschema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The computer ID.",
+						},
+						"status": resourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Final activity status, e.g. `succeeded`, `failed` or `canceled`, or the last status seen when the wait timed out.",
+						},
+						"exit_code": resourceschema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Exit code of the script, when it ran.",
+						},
+						"output": resourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Output of the script. Landscape reports stdout and stderr combined.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ScriptExecutionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the activity that started the execution.")
+}
+
+func (r *ScriptExecutionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *ScriptExecutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ScriptExecutionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, diags := plan.targetQuery(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without matching computers no per-computer activities are ever
+	// created, and waiting for them would only run into wait_timeout.
+	matched, diags := anyComputerMatches(ctx, r.client, query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !matched {
+		resp.Diagnostics.AddError("Script execution targeted no computers",
+			fmt.Sprintf("No computers matched %q.", query))
+		return
+	}
+
+	timeLimit := int(plan.TimeLimit.ValueInt64())
+	params := &landscape.LegacyExecuteScriptParams{
+		Query:     query,
+		ScriptId:  int(plan.ScriptId.ValueInt64()),
+		Username:  plan.Username.ValueStringPointer(),
+		TimeLimit: &timeLimit,
+	}
+
+	rawResp, err := r.client.LegacyExecuteScript(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to execute script", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Failed to execute script", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return
+	}
+
+	activity, err := landscape.ParseLegacyResponse[legacyActivity](body)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse script execution response", err.Error())
+		return
+	}
+	plan.Id = types.Int64Value(int64(activity.Id))
+
+	children, timedOut, diags := r.wait(ctx, activity.Id, time.Duration(plan.WaitTimeout.ValueInt64())*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	succeeded, failed, results, diags := scriptExecutionResults(children)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Succeeded = types.Int64Value(int64(succeeded))
+	plan.Failed = types.Int64Value(int64(failed))
+	plan.Results = results
+
+	// Save the results even when the threshold is missed so they can be
+	// inspected; the error taints the resource and the next apply re-runs it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})...)
+
+	if timedOut {
+		resp.Diagnostics.AddWarning("Timed out waiting for script execution",
+			fmt.Sprintf("Activity %d had not finished on every computer after %d seconds; unfinished computers count as not succeeded.",
+				activity.Id, plan.WaitTimeout.ValueInt64()))
+	}
+	total := succeeded + failed
+	if total == 0 {
+		resp.Diagnostics.AddError("Script execution targeted no computers",
+			fmt.Sprintf("No computers matched %q.", query))
+		return
+	}
+	if ratio := float64(succeeded) / float64(total); ratio < plan.SuccessThreshold.ValueFloat64() {
+		resp.Diagnostics.AddError("Script execution did not meet the success threshold",
+			fmt.Sprintf("%d of %d computers succeeded (%.0f%%), below the required %.0f%%. See the results attribute for per-computer output.",
+				succeeded, total, ratio*100, plan.SuccessThreshold.ValueFloat64()*100))
+	}
+}
+
+func (r *ScriptExecutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// An execution is a finished event; its recorded results do not change.
+	var state ScriptExecutionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})...)
+}
+
+func (r *ScriptExecutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only success_threshold and wait_timeout can change in place; they
+	// apply to the next run.
+	var plan ScriptExecutionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})...)
+}
+
+func (r *ScriptExecutionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Nothing to undo in Landscape; the activity history is kept.
+}
+
+// wait polls the per-computer activities started by the parent activity
+// until all of them have finished or timeout elapses.
+func (r *ScriptExecutionResource) wait(ctx context.Context, parentID int, timeout time.Duration) ([]legacyActivity, bool, diag.Diagnostics) {
+	query := fmt.Sprintf("parent-id:%d", parentID)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		children, diags := fetchActivities(ctx, r.client, query)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+		if allActivitiesFinished(children) {
+			return children, false, diags
+		}
+		if time.Now().After(deadline) {
+			return children, true, diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Interrupted waiting for script execution", ctx.Err().Error())
+			return children, false, diags
+		case <-time.After(scriptExecutionPollInterval):
+		}
+	}
+}
+
+// targetQuery builds the computer selection query from whichever of query,
+// tag or computer_ids is set.
+func (m ScriptExecutionResourceModel) targetQuery(ctx context.Context) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.Query.IsNull():
+		return m.Query.ValueString(), diags
+	case !m.Tag.IsNull():
+		return computerQuery("", m.Tag.ValueString(), ""), diags
+	}
+
+	var ids []int64
+	diags.Append(m.ComputerIds.ElementsAs(ctx, &ids, false)...)
+	return computerIDsQuery(ids), diags
+}
+
+func allActivitiesFinished(activities []legacyActivity) bool {
+	for _, a := range activities {
+		if !a.finished() {
+			return false
+		}
+	}
+	return len(activities) > 0
+}
+
+// scriptExecutionResults converts per-computer activities into the results
+// list, counting every activity that did not succeed as failed.
+func scriptExecutionResults(activities []legacyActivity) (int, int, types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: scriptExecutionResultAttrTypes}
+
+	sorted := make([]legacyActivity, len(activities))
+	copy(sorted, activities)
+	sort.Slice(sorted, func(i, j int) bool {
+		return computerIDOf(sorted[i]) < computerIDOf(sorted[j])
+	})
+
+	var diags diag.Diagnostics
+	succeeded, failed := 0, 0
+	elems := make([]attr.Value, 0, len(sorted))
+	for _, a := range sorted {
+		if a.ActivityStatus == activityStatusSucceeded {
+			succeeded++
+		} else {
+			failed++
+		}
+
+		exitCode := types.Int64Null()
+		if a.ResultCode != nil {
+			exitCode = types.Int64Value(int64(*a.ResultCode))
+		}
+		obj, d := types.ObjectValue(scriptExecutionResultAttrTypes, map[string]attr.Value{
+			"computer_id": types.Int64Value(computerIDOf(a)),
+			"status":      types.StringValue(a.ActivityStatus),
+			"exit_code":   exitCode,
+			"output":      types.StringPointerValue(a.ResultText),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+	return succeeded, failed, list, diags
+}
+
+func computerIDOf(a legacyActivity) int64 {
+	if a.ComputerId == nil {
+		return 0
+	}
+	return int64(*a.ComputerId)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+func TestScriptExecutionResourceMetadata(t *testing.T) {
+	res := NewScriptExecutionResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_script_execution" {
+		t.Fatalf("expected resource type name landscape_script_execution, got %q", resp.TypeName)
+	}
+}
+
+func TestScriptExecutionTargetQuery(t *testing.T) {
+	ctx := context.Background()
+	base := ScriptExecutionResourceModel{
+		Query:       types.StringNull(),
+		Tag:         types.StringNull(),
+		ComputerIds: types.SetNull(types.Int64Type),
+	}
+
+	withTag := base
+	withTag.Tag = types.StringValue("web")
+	if got, _ := withTag.targetQuery(ctx); got != "tag:web" {
+		t.Errorf("expected tag query, got %q", got)
+	}
+
+	withIDs := base
+	withIDs.ComputerIds = types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(7), types.Int64Value(3)})
+	if got, _ := withIDs.targetQuery(ctx); got != "id:3 OR id:7" {
+		t.Errorf("expected id query, got %q", got)
+	}
+}
+
+func TestScriptExecutionResults(t *testing.T) {
+	one, two, three := 1, 2, 3
+	zero, five := 0, 5
+	out := "ok"
+	activities := []legacyActivity{
+		{ComputerId: &three, ActivityStatus: activityStatusFailed, ResultCode: &five},
+		{ComputerId: &one, ActivityStatus: activityStatusSucceeded, ResultCode: &zero, ResultText: &out},
+		{ComputerId: &two, ActivityStatus: "delivered"},
+	}
+
+	succeeded, failed, list, diags := scriptExecutionResults(activities)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if succeeded != 1 || failed != 2 {
+		t.Fatalf("expected 1 succeeded and 2 failed, got %d and %d", succeeded, failed)
+	}
+	first := list.Elements()[0].(types.Object).Attributes()                                                           //nolint:forcetypeassert
+	if first["computer_id"].(types.Int64).ValueInt64() != 1 || first["output"].(types.String).ValueString() != "ok" { //nolint:forcetypeassert
+		t.Fatalf("expected results ordered by computer ID, got %v", list)
+	}
+	if allActivitiesFinished(activities) {
+		t.Fatal("expected a delivered activity to count as unfinished")
+	}
+}
+
+func TestAnyComputerMatches(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("limit"); got != "1" {
+			t.Errorf("expected a single row to be requested, got limit %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("query") == "tag:web" {
+			fmt.Fprint(w, `[{"id": 1, "hostname": "web-1"}]`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := landscape.NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for query, want := range map[string]bool{"tag:web": true, "tag:none": false} {
+		got, diags := anyComputerMatches(context.Background(), client, query)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", query, diags)
+		}
+		if got != want {
+			t.Errorf("%s: expected %v, got %v", query, want, got)
+		}
+	}
+}
+
+func TestAccScriptExecutionResourceMultipleTargets(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptExecutionResourceMultipleTargetsConfig,
+				ExpectError: regexp.MustCompile(`(?i)exactly one`),
+			},
+		},
+	})
+}
+
+const testAccScriptExecutionResourceMultipleTargetsConfig = `
+provider "landscape" {}
+
+resource "landscape_script_execution" "test" {
+  script_id = 1
+  tag       = "web"
+  query     = "distribution:noble"
+}
+`
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+// scriptProfileSummaryAttrTypes describes one element of the
+// landscape_script_profiles list. It mirrors ScriptProfileDataSourceModel.
+var scriptProfileSummaryAttrTypes = map[string]attr.Type{
+	"id":             types.Int64Type,
+	"title":          types.StringType,
+	"script_id":      types.Int64Type,
+	"access_group":   types.StringType,
+	"username":       types.StringType,
+	"time_limit":     types.Int64Type,
+	"all_computers":  types.BoolType,
+	"tags":           types.SetType{ElemType: types.StringType},
+	"archived":       types.BoolType,
+	"created_at":     types.StringType,
+	"last_edited_at": types.StringType,
+	"trigger":        types.ObjectType{AttrTypes: triggerAttrTypes},
+
+	"last_run_at":        types.StringType,
+	"next_run_at":        types.StringType,
+	"computers_targeted": types.Int64Type,
+	"last_run_succeeded": types.Int64Type,
+	"last_run_failed":    types.Int64Type,
+}
+
+// scriptProfileRunStats is what Landscape reports about a profile's runs. The
+// last run fields are null until the profile has run.
+type scriptProfileRunStats struct {
+	LastRunAt         types.String
+	NextRunAt         types.String
+	ComputersTargeted types.Int64
+	LastRunSucceeded  types.Int64
+	LastRunFailed     types.Int64
+}
+
+// fetchScriptProfiles lists the script profiles in the account. archived
+// selects active, archived or all profiles.
+func fetchScriptProfiles(ctx context.Context, client *landscape.ClientWithResponses, archived landscape.ListScriptProfilesParamsArchived) ([]landscape.ScriptProfileDetail, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.ListScriptProfilesWithResponse(ctx, &landscape.ListScriptProfilesParams{Archived: &archived})
+	if err != nil {
+		diags.AddError("Failed to read script profiles", err.Error())
+		return nil, diags
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Failed to read script profiles", fmt.Sprintf("status %s: %s", res.Status(), res.Body))
+		return nil, diags
+	}
+	return res.JSON200.Results, diags
+}
+
+// scriptProfileDetailToModel converts a script profile returned by the API
+// into data source state.
+func scriptProfileDetailToModel(ctx context.Context, detail landscape.ScriptProfileDetail, stats scriptProfileRunStats) (ScriptProfileDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sortedTags := make([]string, len(detail.Tags))
+	copy(sortedTags, detail.Tags)
+	sort.Strings(sortedTags)
+	tags, d := types.SetValueFrom(ctx, types.StringType, sortedTags)
+	diags.Append(d...)
+
+	triggerObj, d := triggerResponseToObject(detail.Trigger)
+	diags.Append(d...)
+
+	return ScriptProfileDataSourceModel{
+		Id:           types.Int64Value(int64(detail.Id)),
+		Title:        types.StringValue(detail.Title),
+		ScriptId:     types.Int64Value(int64(detail.ScriptId)),
+		AccessGroup:  types.StringValue(detail.AccessGroup),
+		Username:     types.StringValue(detail.Username),
+		TimeLimit:    types.Int64Value(int64(detail.TimeLimit)),
+		AllComputers: types.BoolValue(detail.AllComputers),
+		Tags:         tags,
+		Archived:     types.BoolValue(detail.Archived),
+		CreatedAt:    types.StringValue(detail.CreatedAt),
+		LastEditedAt: types.StringValue(detail.LastEditedAt),
+		Trigger:      triggerObj,
+
+		LastRunAt:         stats.LastRunAt,
+		NextRunAt:         stats.NextRunAt,
+		ComputersTargeted: stats.ComputersTargeted,
+		LastRunSucceeded:  stats.LastRunSucceeded,
+		LastRunFailed:     stats.LastRunFailed,
+	}, diags
+}
+
+// fetchScriptProfileRunStats reads the per-computer activities of the
+// profile's last run and summarises them with the profile's own run times.
+// The statistics are informational, so failing to read the run only warns
+// and leaves the counts null.
+func fetchScriptProfileRunStats(ctx context.Context, client *landscape.ClientWithResponses, detail landscape.ScriptProfileDetail) (scriptProfileRunStats, diag.Diagnostics) {
+	stats, d := fetchLastRunStats(ctx, client, detail)
+	if !d.HasError() {
+		return stats, d
+	}
+	diags := errorsAsWarnings(d, "Failed to read script profile run results",
+		fmt.Sprintf("Could not read the last run of script profile %d, so last_run_succeeded and last_run_failed are left unset.", detail.Id))
+	return scriptProfileRunStatsFrom(detail, nil, false), diags
+}
+
+// errorsAsWarnings reports the errors in diags as warnings with the given
+// summary, prefixing each detail with the consequence of the failure.
+func errorsAsWarnings(diags diag.Diagnostics, summary, consequence string) diag.Diagnostics {
+	var out diag.Diagnostics
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			out.Append(d)
+			continue
+		}
+		out.AddWarning(summary, fmt.Sprintf("%s %s: %s", consequence, d.Summary(), d.Detail()))
+	}
+	return out
+}
+
+func fetchLastRunStats(ctx context.Context, client *landscape.ClientWithResponses, detail landscape.ScriptProfileDetail) (scriptProfileRunStats, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if detail.Activities.LastActivity == nil {
+		return scriptProfileRunStatsFrom(detail, nil, false), diags
+	}
+
+	runID, ok := lastRunID(detail.Activities)
+	if !ok {
+		// Fall back to the newest run; activity IDs increase over time.
+		runIDs, d := scriptProfileRunIDs(ctx, client, detail.Id)
+		diags.Append(d...)
+		if diags.HasError() {
+			return scriptProfileRunStats{}, diags
+		}
+		for _, id := range runIDs {
+			runID = max(runID, id)
+		}
+		ok = len(runIDs) > 0
+	}
+	if !ok {
+		return scriptProfileRunStatsFrom(detail, nil, false), diags
+	}
+
+	children, d := fetchActivities(ctx, client, fmt.Sprintf("parent-id:%d", runID))
+	diags.Append(d...)
+	if diags.HasError() {
+		return scriptProfileRunStats{}, diags
+	}
+	return scriptProfileRunStatsFrom(detail, children, true), diags
+}
+
+// lastRunID returns the ID of the activity of the profile's last run, if
+// Landscape included it.
+func lastRunID(info landscape.ScriptProfileActivityInfo) (int, bool) {
+	if info.LastActivity == nil {
+		return 0, false
+	}
+	id, ok := (*info.LastActivity)["id"].(float64)
+	return int(id), ok
+}
+
+// scriptProfileRunStatsFrom summarises a profile given the per-computer
+// activities of its last run; hasRun is false if it has not run. Activities
+// that have not finished count as neither succeeded nor failed.
+func scriptProfileRunStatsFrom(detail landscape.ScriptProfileDetail, run []legacyActivity, hasRun bool) scriptProfileRunStats {
+	stats := scriptProfileRunStats{
+		LastRunAt:         types.StringNull(),
+		NextRunAt:         types.StringNull(),
+		ComputersTargeted: types.Int64Value(int64(detail.Computers.NumAssociatedComputers)),
+		LastRunSucceeded:  types.Int64Null(),
+		LastRunFailed:     types.Int64Null(),
+	}
+
+	if value, err := detail.Trigger.ValueByDiscriminator(); err == nil {
+		switch t := value.(type) {
+		case landscape.ScriptProfileScheduleTrigger:
+			stats.LastRunAt = formatOptionalTriggerTime(t.LastRun)
+			stats.NextRunAt = formatOptionalTriggerTime(t.NextRun)
+		case landscape.ScriptProfileOneTimeTrigger:
+			stats.LastRunAt = formatOptionalTriggerTime(t.LastRun)
+			stats.NextRunAt = formatOptionalTriggerTime(t.NextRun)
+		}
+	}
+
+	if !hasRun {
+		return stats
+	}
+	var succeeded, failed int64
+	var firstCreated time.Time
+	for _, a := range run {
+		switch a.ActivityStatus {
+		case activityStatusSucceeded:
+			succeeded++
+		case activityStatusFailed, activityStatusCanceled:
+			failed++
+		}
+		if created, ok := parseActivityTime(a.CreationTime); ok && (firstCreated.IsZero() || created.Before(firstCreated)) {
+			firstCreated = created
+		}
+	}
+	stats.LastRunSucceeded = types.Int64Value(succeeded)
+	stats.LastRunFailed = types.Int64Value(failed)
+	// Event triggers carry no run times; the run started when its first
+	// activity was created.
+	if stats.LastRunAt.IsNull() && !firstCreated.IsZero() {
+		stats.LastRunAt = formatTriggerTime(firstCreated.UTC())
+	}
+	return stats
+}
+
+// formatOptionalTriggerTime formats a trigger time as RFC3339 in UTC, or null
+// when absent.
+func formatOptionalTriggerTime(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return formatTriggerTime(t.UTC())
+}
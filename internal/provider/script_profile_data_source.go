@@ -6,10 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	landscape "github.com/jansdhillon/landscape-go-api-client/client"
 )
@@ -38,6 +43,12 @@ type ScriptProfileDataSourceModel struct {
 	CreatedAt    types.String `tfsdk:"created_at"`
 	LastEditedAt types.String `tfsdk:"last_edited_at"`
 	Trigger      types.Object `tfsdk:"trigger"`
+
+	LastRunAt         types.String `tfsdk:"last_run_at"`
+	NextRunAt         types.String `tfsdk:"next_run_at"`
+	ComputersTargeted types.Int64  `tfsdk:"computers_targeted"`
+	LastRunSucceeded  types.Int64  `tfsdk:"last_run_succeeded"`
+	LastRunFailed     types.Int64  `tfsdk:"last_run_failed"`
 }
 
 func (d *ScriptProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,15 +57,20 @@ func (d *ScriptProfileDataSource) Metadata(_ context.Context, req datasource.Met
 
 func (d *ScriptProfileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Reads a Landscape script profile by ID.",
+		MarkdownDescription: "Reads a Landscape script profile by ID or title.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				Required:            true,
-				MarkdownDescription: "The unique identifier for the script profile.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the script profile. Exactly one of `id` or `title` must be set.",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("title")),
+				},
 			},
 			"title": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The title of the script profile.",
+				MarkdownDescription: "The title of the script profile. When set instead of `id`, the active profile with exactly this title is looked up; it is an error if no profile or more than one profile has the title.",
 			},
 			"script_id": schema.Int64Attribute{
 				Computed:            true,
@@ -119,6 +135,26 @@ func (d *ScriptProfileDataSource) Schema(_ context.Context, _ datasource.SchemaR
 					},
 				},
 			},
+			"last_run_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the profile last ran (RFC3339), or null if it has not run.",
+			},
+			"next_run_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When Landscape will next run the profile (RFC3339, UTC), or null for `event` triggers and finished `one_time` triggers. For `recurring` triggers, `trigger.recurring.next_run_at` reports the same time in the trigger's `timezone`.",
+			},
+			"computers_targeted": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of computers the profile currently targets.",
+			},
+			"last_run_succeeded": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of computers on which the last run succeeded, or null if the profile has not run.",
+			},
+			"last_run_failed": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of computers on which the last run failed or was canceled, or null if the profile has not run. Computers still running the script count towards neither this nor `last_run_succeeded`.",
+			},
 		},
 	}
 }
@@ -145,43 +181,64 @@ func (d *ScriptProfileDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	res, err := d.client.GetScriptProfileWithResponse(ctx, int(config.Id.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read script profile", err.Error())
-		return
-	}
-	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Failed to read script profile", res.Status())
-		return
+	var detail landscape.ScriptProfileDetail
+	if config.Id.IsNull() {
+		found, diags := findScriptProfileByTitle(ctx, d.client, config.Title.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		detail = found
+	} else {
+		res, err := d.client.GetScriptProfileWithResponse(ctx, int(config.Id.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read script profile", err.Error())
+			return
+		}
+		if res.JSON200 == nil {
+			resp.Diagnostics.AddError("Failed to read script profile", res.Status())
+			return
+		}
+		detail = *res.JSON200
 	}
 
-	detail := res.JSON200
-	sortedTags := make([]string, len(detail.Tags))
-	copy(sortedTags, detail.Tags)
-	sort.Strings(sortedTags)
-	tags, diags := types.SetValueFrom(ctx, types.StringType, sortedTags)
+	stats, diags := fetchScriptProfileRunStats(ctx, d.client, detail)
 	resp.Diagnostics.Append(diags...)
 
-	triggerObj, diags := triggerResponseToObject(detail.Trigger)
+	state, diags := scriptProfileDetailToModel(ctx, detail, stats)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state := ScriptProfileDataSourceModel{
-		Id:           types.Int64Value(int64(detail.Id)),
-		Title:        types.StringValue(detail.Title),
-		ScriptId:     types.Int64Value(int64(detail.ScriptId)),
-		AccessGroup:  types.StringValue(detail.AccessGroup),
-		Username:     types.StringValue(detail.Username),
-		TimeLimit:    types.Int64Value(int64(detail.TimeLimit)),
-		AllComputers: types.BoolValue(detail.AllComputers),
-		Tags:         tags,
-		Archived:     types.BoolValue(detail.Archived),
-		CreatedAt:    types.StringValue(detail.CreatedAt),
-		LastEditedAt: types.StringValue(detail.LastEditedAt),
-		Trigger:      triggerObj,
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// findScriptProfileByTitle returns the active script profile titled exactly
+// title. It errors when no profile or more than one profile matches.
+func findScriptProfileByTitle(ctx context.Context, client *landscape.ClientWithResponses, title string) (landscape.ScriptProfileDetail, diag.Diagnostics) {
+	profiles, diags := fetchScriptProfiles(ctx, client, landscape.ListScriptProfilesParamsArchivedActive)
+	if diags.HasError() {
+		return landscape.ScriptProfileDetail{}, diags
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	var matches []landscape.ScriptProfileDetail
+	var ids []string
+	for _, p := range profiles {
+		if p.Title == title {
+			matches = append(matches, p)
+			ids = append(ids, strconv.Itoa(p.Id))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(path.Root("title"), "Script profile not found", fmt.Sprintf("No active script profile is titled %q.", title))
+	case 1:
+		return matches[0], diags
+	default:
+		diags.AddAttributeError(path.Root("title"), "Ambiguous script profile title",
+			fmt.Sprintf("%d script profiles are titled %q (IDs %s). Set `id` instead.", len(matches), title, strings.Join(ids, ", ")))
+	}
+	return landscape.ScriptProfileDetail{}, diags
 }
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ list.ListResource = &ScriptProfileListResource{}
+var _ list.ListResourceWithConfigure = &ScriptProfileListResource{}
+
+func NewScriptProfileListResource() list.ListResource {
+	return &ScriptProfileListResource{}
+}
+
+type ScriptProfileListResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ScriptProfileListResourceModel struct {
+	TitleRegex        types.String `tfsdk:"title_regex"`
+	ScriptId          types.Int64  `tfsdk:"script_id"`
+	IncludeArchived   types.Bool   `tfsdk:"include_archived"`
+	IncludeRunResults types.Bool   `tfsdk:"include_run_results"`
+}
+
+func (r *ScriptProfileListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script_profile"
+}
+
+func (r *ScriptProfileListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the script profiles in the account. Archived profiles are skipped unless `include_archived` is set.",
+		Attributes: map[string]listschema.Attribute{
+			"title_regex": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list profiles whose title matches this regular expression.",
+			},
+			"script_id": listschema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list profiles that run this script.",
+			},
+			"include_archived": listschema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Also list archived profiles.",
+			},
+			"include_run_results": listschema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When resources are included, also read the activities of each profile's last run to set `last_run_succeeded` and `last_run_failed`. This makes one request per profile, so it is off by default and the counts are null.",
+			},
+		},
+	}
+}
+
+func (r *ScriptProfileListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResource(req, resp)
+}
+
+func (r *ScriptProfileListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ScriptProfileListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	title, titleDiags := compileTitleRegex(config.TitleRegex)
+	diags.Append(titleDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	archived := landscape.ListScriptProfilesParamsArchivedActive
+	if config.IncludeArchived.ValueBool() {
+		archived = landscape.ListScriptProfilesParamsArchivedAll
+	}
+	profiles, diags := fetchScriptProfiles(ctx, r.client, archived)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := scriptProfileFilter{scriptID: config.ScriptId.ValueInt64()}
+	var matches []landscape.ScriptProfileDetail
+	for _, p := range profiles {
+		if filter.matches(p) && (title == nil || title.MatchString(p.Title)) {
+			matches = append(matches, p)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matches, func(p landscape.ScriptProfileDetail, result *list.ListResult) {
+		result.DisplayName = p.Title
+		result.Diagnostics.Append(result.Identity.Set(ctx, idIdentityModel{Id: types.Int64Value(int64(p.Id))})...)
+		if !req.IncludeResource {
+			return
+		}
+
+		stats := scriptProfileRunStatsFrom(p, nil, false)
+		if config.IncludeRunResults.ValueBool() {
+			var diags diag.Diagnostics
+			stats, diags = fetchScriptProfileRunStats(ctx, r.client, p)
+			result.Diagnostics.Append(diags...)
+		}
+		state, diags := scriptProfileDetailToState(ctx, &p, types.ObjectNull(triggerBlockAttrTypes), stats)
+		result.Diagnostics.Append(diags...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+	})
+}
@@ -11,20 +11,21 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	landscape "github.com/jansdhillon/landscape-go-api-client/client"
 )
 
 var _ resource.Resource = &ScriptProfileResource{}
 var _ resource.ResourceWithImportState = &ScriptProfileResource{}
+var _ resource.ResourceWithIdentity = &ScriptProfileResource{}
+var _ resource.ResourceWithValidateConfig = &ScriptProfileResource{}
+var _ resource.ResourceWithModifyPlan = &ScriptProfileResource{}
+var _ resource.ResourceWithUpgradeState = &ScriptProfileResource{}
 
 func NewScriptProfileResource() resource.Resource {
 	return &ScriptProfileResource{}
@@ -34,28 +35,26 @@ type ScriptProfileResource struct {
 	client *landscape.ClientWithResponses
 }
 
-// triggerAttrTypes is the Terraform attribute type map for the trigger block.
-var triggerAttrTypes = map[string]attr.Type{
-	"type":        types.StringType,
-	"event_type":  types.StringType,
-	"interval":    types.StringType,
-	"start_after": types.StringType,
-	"timestamp":   types.StringType,
-}
-
 type ScriptProfileResourceModel struct {
-	Id           types.Int64  `tfsdk:"id"`
-	Title        types.String `tfsdk:"title"`
-	ScriptId     types.Int64  `tfsdk:"script_id"`
-	AccessGroup  types.String `tfsdk:"access_group"`
-	Username     types.String `tfsdk:"username"`
-	TimeLimit    types.Int64  `tfsdk:"time_limit"`
-	AllComputers types.Bool   `tfsdk:"all_computers"`
-	Tags         types.Set    `tfsdk:"tags"`
-	Archived     types.Bool   `tfsdk:"archived"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	LastEditedAt types.String `tfsdk:"last_edited_at"`
-	Trigger      types.Object `tfsdk:"trigger"`
+	Id            types.Int64  `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	ScriptId      types.Int64  `tfsdk:"script_id"`
+	ScriptVersion types.Int64  `tfsdk:"script_version"`
+	AccessGroup   types.String `tfsdk:"access_group"`
+	Username      types.String `tfsdk:"username"`
+	TimeLimit     types.Int64  `tfsdk:"time_limit"`
+	AllComputers  types.Bool   `tfsdk:"all_computers"`
+	Tags          types.Set    `tfsdk:"tags"`
+	Archived      types.Bool   `tfsdk:"archived"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	LastEditedAt  types.String `tfsdk:"last_edited_at"`
+	Trigger       types.Object `tfsdk:"trigger"`
+
+	LastRunAt         types.String `tfsdk:"last_run_at"`
+	NextRunAt         types.String `tfsdk:"next_run_at"`
+	ComputersTargeted types.Int64  `tfsdk:"computers_targeted"`
+	LastRunSucceeded  types.Int64  `tfsdk:"last_run_succeeded"`
+	LastRunFailed     types.Int64  `tfsdk:"last_run_failed"`
 }
 
 func (r *ScriptProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,6 +63,7 @@ func (r *ScriptProfileResource) Metadata(_ context.Context, req resource.Metadat
 
 func (r *ScriptProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resourceschema.Schema{
+		Version:             1,
 		MarkdownDescription: "Manages a Landscape script profile. A script profile defines when and how a V2 script is executed across targeted computers.",
 		Attributes: map[string]resourceschema.Attribute{
 			"id": resourceschema.Int64Attribute{
@@ -78,6 +78,10 @@ func (r *ScriptProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 				Required:            true,
 				MarkdownDescription: "The ID of the V2 script this profile executes.",
 			},
+			"script_version": resourceschema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Pins the profile to a version of the script. Create and update fail unless the script's current `version_number` matches, so a rollout never picks up unreviewed code. Landscape always runs the script's latest version, so refresh records the script's current version here: when the script moves past the pin, the next plan shows `script_version` changing back to the pin and the apply fails until the pin is updated or the script reverted. If the script cannot be read during refresh, a warning is shown and the pin is left as it was. Not set on import; add it to the configuration and the next apply checks it. See the `landscape_script_v2_versions` data source.",
+			},
 			"username": resourceschema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The Linux username under which the script will run.",
@@ -114,39 +118,35 @@ func (r *ScriptProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 				MarkdownDescription: "When the script profile was last modified.",
 			},
-			"trigger": resourceschema.SingleNestedAttribute{
-				Required:            true,
-				MarkdownDescription: "The trigger that controls when the script profile executes.",
-				Attributes: map[string]resourceschema.Attribute{
-					"type": resourceschema.StringAttribute{
-						Required:            true,
-						MarkdownDescription: "Trigger type: `event`, `recurring`, or `one_time`.",
-						Validators: []validator.String{
-							stringvalidator.OneOf("event", "recurring", "one_time"),
-						},
-					},
-					"event_type": resourceschema.StringAttribute{
-						Optional:            true,
-						MarkdownDescription: "For `event` triggers: the event type (e.g. `post_enrollment`).",
-					},
-					"interval": resourceschema.StringAttribute{
-						Optional:            true,
-						MarkdownDescription: "For `recurring` triggers: cron expression (e.g. `0 * * * *`).",
-					},
-					"start_after": resourceschema.StringAttribute{
-						Optional:            true,
-						MarkdownDescription: "For `recurring` triggers: RFC3339 datetime after which the schedule begins.",
-					},
-					"timestamp": resourceschema.StringAttribute{
-						Optional:            true,
-						MarkdownDescription: "For `one_time` triggers: RFC3339 datetime at which the profile executes.",
-					},
-				},
+			"trigger": scriptProfileTriggerSchema(),
+			"last_run_at": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the profile last ran (RFC3339), or null if it has not run. Refreshed on every read, so run statistics can be exported as outputs for monitoring.",
+			},
+			"next_run_at": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When Landscape will next run the profile (RFC3339, UTC), or null for `event` triggers and finished `one_time` triggers. For `recurring` triggers, `trigger.recurring.next_run_at` reports the same time in the trigger's `timezone`.",
+			},
+			"computers_targeted": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of computers the profile currently targets.",
+			},
+			"last_run_succeeded": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of computers on which the last run succeeded, or null if the profile has not run.",
+			},
+			"last_run_failed": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of computers on which the last run failed or was canceled, or null if the profile has not run. Computers still running the script count towards neither this nor `last_run_succeeded`.",
 			},
 		},
 	}
 }
 
+func (r *ScriptProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Script profile identifier.")
+}
+
 func (r *ScriptProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -162,6 +162,83 @@ func (r *ScriptProfileResource) Configure(_ context.Context, req resource.Config
 	r.client = client
 }
 
+func (r *ScriptProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var trigger types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trigger"), &trigger)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateScriptProfileTrigger(trigger)...)
+}
+
+// ModifyPlan rejects a one_time trigger scheduled in the past. The check only
+// applies when the timestamp is set or changed, so a profile that has already
+// run can still be planned.
+func (r *ScriptProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("trigger"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("trigger"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOneTimeTimestamp(triggerAttr(planned, "one_time", "timestamp"), triggerAttr(prior, "one_time", "timestamp"), time.Now())...)
+}
+
+// UpgradeState moves version 0 state, whose trigger was a flat object
+// discriminated by `type`, to the nested event/recurring/one_time trigger.
+func (r *ScriptProfileResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := scriptProfileSchemaV0()
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schemaV0,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior scriptProfileResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				trigger, diags := triggerV0ToBlock(prior.Trigger)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := ScriptProfileResourceModel{
+					Id:            prior.Id,
+					Title:         prior.Title,
+					ScriptId:      prior.ScriptId,
+					ScriptVersion: prior.ScriptVersion,
+					AccessGroup:   prior.AccessGroup,
+					Username:      prior.Username,
+					TimeLimit:     prior.TimeLimit,
+					AllComputers:  prior.AllComputers,
+					Tags:          prior.Tags,
+					Archived:      prior.Archived,
+					CreatedAt:     prior.CreatedAt,
+					LastEditedAt:  prior.LastEditedAt,
+					Trigger:       trigger,
+
+					LastRunAt:         types.StringNull(),
+					NextRunAt:         types.StringNull(),
+					ComputersTargeted: types.Int64Null(),
+					LastRunSucceeded:  types.Int64Null(),
+					LastRunFailed:     types.Int64Null(),
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
 func (r *ScriptProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ScriptProfileResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -169,6 +246,11 @@ func (r *ScriptProfileResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	resp.Diagnostics.Append(checkScriptVersionPin(ctx, r.client, plan.ScriptId, plan.ScriptVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body, diags := planToCreateBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -185,12 +267,17 @@ func (r *ScriptProfileResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	state, diags := scriptProfileDetailToState(ctx, res.JSON201)
+	stats, diags := fetchScriptProfileRunStats(ctx, r.client, *res.JSON201)
+	resp.Diagnostics.Append(diags...)
+
+	state, diags := scriptProfileDetailToState(ctx, res.JSON201, plan.Trigger, stats)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.ScriptVersion = plan.ScriptVersion
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})...)
 }
 
 func (r *ScriptProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -214,12 +301,28 @@ func (r *ScriptProfileResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	newState, diags := scriptProfileDetailToState(ctx, res.JSON200)
+	stats, diags := fetchScriptProfileRunStats(ctx, r.client, *res.JSON200)
+	resp.Diagnostics.Append(diags...)
+
+	newState, diags := scriptProfileDetailToState(ctx, res.JSON200, state.Trigger, stats)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newState.ScriptVersion = state.ScriptVersion
+	if !state.ScriptVersion.IsNull() {
+		// A script that can no longer be read must not block planning or
+		// destroying the profile, so the pin is kept as it was.
+		current, diags := fetchScriptVersionNumber(ctx, r.client, newState.ScriptId.ValueInt64())
+		if diags.HasError() {
+			resp.Diagnostics.Append(errorsAsWarnings(diags, "Failed to check script version",
+				fmt.Sprintf("Could not read script %d, so script_version keeps its previous value.", newState.ScriptId.ValueInt64()))...)
+		} else {
+			newState.ScriptVersion = observedScriptVersion(state.ScriptVersion, current)
+		}
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: newState.Id})...)
 }
 
 func (r *ScriptProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -231,6 +334,11 @@ func (r *ScriptProfileResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	resp.Diagnostics.Append(checkScriptVersionPin(ctx, r.client, plan.ScriptId, plan.ScriptVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patch, diags := planToPatchBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -261,12 +369,17 @@ func (r *ScriptProfileResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	newState, diags := scriptProfileDetailToState(ctx, res.JSON200)
+	stats, diags := fetchScriptProfileRunStats(ctx, r.client, *res.JSON200)
+	resp.Diagnostics.Append(diags...)
+
+	newState, diags := scriptProfileDetailToState(ctx, res.JSON200, plan.Trigger, stats)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newState.ScriptVersion = plan.ScriptVersion
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: newState.Id})...)
 }
 
 // Delete archives the script profile (Landscape has no hard delete for profiles).
@@ -296,12 +409,7 @@ func (r *ScriptProfileResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *ScriptProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected a numeric script profile ID, got: %s", req.ID))
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	importStateNumericID(ctx, "script profile", req, resp)
 }
 
 func planToCreateBody(ctx context.Context, plan ScriptProfileResourceModel) (landscape.ScriptProfileCreateBody, diag.Diagnostics) {
@@ -358,117 +466,10 @@ func planToPatchBody(ctx context.Context, plan ScriptProfileResourceModel) (land
 	}, diags
 }
 
-func planTriggerToCreateRequest(_ context.Context, triggerObj types.Object) (landscape.ScriptProfileTriggerCreateRequest, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var t landscape.ScriptProfileTriggerCreateRequest
-
-	attrs := triggerObj.Attributes()
-	triggerType := attrs["type"].(types.String).ValueString() //nolint:forcetypeassert
-
-	switch triggerType {
-	case "event":
-		eventType := attrs["event_type"].(types.String).ValueString() //nolint:forcetypeassert
-		err := t.FromScriptProfileEventTrigger(landscape.ScriptProfileEventTrigger{
-			TriggerType: landscape.Event,
-			EventType:   landscape.ScriptProfileEventType(eventType),
-		})
-		if err != nil {
-			diags.AddError("Failed to build event trigger", err.Error())
-		}
-	case "recurring":
-		interval := attrs["interval"].(types.String).ValueString()         //nolint:forcetypeassert
-		startAfterStr := attrs["start_after"].(types.String).ValueString() //nolint:forcetypeassert
-		startAfter, err := time.Parse(time.RFC3339, startAfterStr)
-		if err != nil {
-			diags.AddError("Invalid start_after", fmt.Sprintf("Must be RFC3339: %s", err))
-			return t, diags
-		}
-		err = t.FromScriptProfileScheduleDraftTrigger(landscape.ScriptProfileScheduleDraftTrigger{
-			TriggerType: landscape.ScriptProfileScheduleDraftTriggerTriggerTypeRecurring,
-			Interval:    interval,
-			StartAfter:  startAfter,
-		})
-		if err != nil {
-			diags.AddError("Failed to build recurring trigger", err.Error())
-		}
-	case "one_time":
-		tsStr := attrs["timestamp"].(types.String).ValueString() //nolint:forcetypeassert
-		ts, err := time.Parse(time.RFC3339, tsStr)
-		if err != nil {
-			diags.AddError("Invalid timestamp", fmt.Sprintf("Must be RFC3339: %s", err))
-			return t, diags
-		}
-		err = t.FromScriptProfileOneTimeDraftTrigger(landscape.ScriptProfileOneTimeDraftTrigger{
-			TriggerType: landscape.ScriptProfileOneTimeDraftTriggerTriggerTypeOneTime,
-			Timestamp:   ts,
-		})
-		if err != nil {
-			diags.AddError("Failed to build one_time trigger", err.Error())
-		}
-	default:
-		diags.AddError("Invalid trigger type", fmt.Sprintf("Must be one of: event, recurring, one_time. Got: %s", triggerType))
-	}
-	return t, diags
-}
-
-func planTriggerToPatchRequest(_ context.Context, triggerObj types.Object) (*landscape.ScriptProfileTriggerPatchRequest, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var t landscape.ScriptProfileTriggerPatchRequest
-
-	attrs := triggerObj.Attributes()
-	triggerType := attrs["type"].(types.String).ValueString() //nolint:forcetypeassert
-
-	switch triggerType {
-	case "event":
-		eventType := attrs["event_type"].(types.String).ValueString() //nolint:forcetypeassert
-		err := t.FromScriptProfileEventTrigger(landscape.ScriptProfileEventTrigger{
-			TriggerType: landscape.Event,
-			EventType:   landscape.ScriptProfileEventType(eventType),
-		})
-		if err != nil {
-			diags.AddError("Failed to build event trigger", err.Error())
-		}
-	case "recurring":
-		interval := attrs["interval"].(types.String).ValueString()         //nolint:forcetypeassert
-		startAfterStr := attrs["start_after"].(types.String).ValueString() //nolint:forcetypeassert
-		var startAfterPtr *time.Time
-		if startAfterStr != "" {
-			sa, err := time.Parse(time.RFC3339, startAfterStr)
-			if err != nil {
-				diags.AddError("Invalid start_after", fmt.Sprintf("Must be RFC3339: %s", err))
-				return nil, diags
-			}
-			startAfterPtr = &sa
-		}
-		err := t.FromScriptProfileScheduleDraftEditTrigger(landscape.ScriptProfileScheduleDraftEditTrigger{
-			TriggerType: landscape.ScriptProfileScheduleDraftEditTriggerTriggerTypeRecurring,
-			Interval:    &interval,
-			StartAfter:  startAfterPtr,
-		})
-		if err != nil {
-			diags.AddError("Failed to build recurring trigger", err.Error())
-		}
-	case "one_time":
-		tsStr := attrs["timestamp"].(types.String).ValueString() //nolint:forcetypeassert
-		ts, err := time.Parse(time.RFC3339, tsStr)
-		if err != nil {
-			diags.AddError("Invalid timestamp", fmt.Sprintf("Must be RFC3339: %s", err))
-			return nil, diags
-		}
-		err = t.FromScriptProfileOneTimeDraftTrigger(landscape.ScriptProfileOneTimeDraftTrigger{
-			TriggerType: landscape.ScriptProfileOneTimeDraftTriggerTriggerTypeOneTime,
-			Timestamp:   ts,
-		})
-		if err != nil {
-			diags.AddError("Failed to build one_time trigger", err.Error())
-		}
-	default:
-		diags.AddError("Invalid trigger type", fmt.Sprintf("Must be one of: event, recurring, one_time. Got: %s", triggerType))
-	}
-	return &t, diags
-}
-
-func scriptProfileDetailToState(ctx context.Context, detail *landscape.ScriptProfileDetail) (ScriptProfileResourceModel, diag.Diagnostics) {
+// scriptProfileDetailToState converts a profile and its run statistics to
+// resource state. prior is the trigger from the plan or state, if any; see
+// triggerResponseToBlock.
+func scriptProfileDetailToState(ctx context.Context, detail *landscape.ScriptProfileDetail, prior types.Object, stats scriptProfileRunStats) (ScriptProfileResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	sortedTags := make([]string, len(detail.Tags))
@@ -477,66 +478,104 @@ func scriptProfileDetailToState(ctx context.Context, detail *landscape.ScriptPro
 	tags, d := types.SetValueFrom(ctx, types.StringType, sortedTags)
 	diags.Append(d...)
 
-	triggerObj, d := triggerResponseToObject(detail.Trigger)
+	triggerObj, d := triggerResponseToBlock(detail.Trigger, prior)
 	diags.Append(d...)
 
 	return ScriptProfileResourceModel{
-		Id:           types.Int64Value(int64(detail.Id)),
-		Title:        types.StringValue(detail.Title),
-		ScriptId:     types.Int64Value(int64(detail.ScriptId)),
-		AccessGroup:  types.StringValue(detail.AccessGroup),
-		Username:     types.StringValue(detail.Username),
-		TimeLimit:    types.Int64Value(int64(detail.TimeLimit)),
-		AllComputers: types.BoolValue(detail.AllComputers),
-		Tags:         tags,
-		Archived:     types.BoolValue(detail.Archived),
-		CreatedAt:    types.StringValue(detail.CreatedAt),
-		LastEditedAt: types.StringValue(detail.LastEditedAt),
-		Trigger:      triggerObj,
+		Id:            types.Int64Value(int64(detail.Id)),
+		Title:         types.StringValue(detail.Title),
+		ScriptId:      types.Int64Value(int64(detail.ScriptId)),
+		ScriptVersion: types.Int64Null(),
+		AccessGroup:   types.StringValue(detail.AccessGroup),
+		Username:      types.StringValue(detail.Username),
+		TimeLimit:     types.Int64Value(int64(detail.TimeLimit)),
+		AllComputers:  types.BoolValue(detail.AllComputers),
+		Tags:          tags,
+		Archived:      types.BoolValue(detail.Archived),
+		CreatedAt:     types.StringValue(detail.CreatedAt),
+		LastEditedAt:  types.StringValue(detail.LastEditedAt),
+		Trigger:       triggerObj,
+
+		LastRunAt:         stats.LastRunAt,
+		NextRunAt:         stats.NextRunAt,
+		ComputersTargeted: stats.ComputersTargeted,
+		LastRunSucceeded:  stats.LastRunSucceeded,
+		LastRunFailed:     stats.LastRunFailed,
 	}, diags
 }
 
-func triggerResponseToObject(trigger landscape.ScriptProfileTriggerResponse) (types.Object, diag.Diagnostics) {
-	null := func() types.String { return types.StringNull() }
-
-	// Unmarshal to detect the discriminator field first
-	if ev, err := trigger.AsScriptProfileEventTrigger(); err == nil && string(ev.TriggerType) == "event" {
-		return types.ObjectValue(triggerAttrTypes, map[string]attr.Value{
-			"type":        types.StringValue(string(ev.TriggerType)),
-			"event_type":  types.StringValue(string(ev.EventType)),
-			"interval":    null(),
-			"start_after": null(),
-			"timestamp":   null(),
-		})
+// checkScriptVersionPin verifies that the script's current version matches the
+// pinned version, if one is configured. Landscape always runs the latest
+// version of a script, so a mismatch is reported instead of silently rolling
+// out different code.
+func checkScriptVersionPin(ctx context.Context, client *landscape.ClientWithResponses, scriptID types.Int64, pinned types.Int64) diag.Diagnostics {
+	if pinned.IsNull() || pinned.IsUnknown() {
+		return nil
 	}
 
-	if sched, err := trigger.AsScriptProfileScheduleTrigger(); err == nil && string(sched.TriggerType) == "recurring" {
-		startAfter := null()
-		if !sched.StartAfter.IsZero() {
-			startAfter = types.StringValue(sched.StartAfter.Format(time.RFC3339))
-		}
-		return types.ObjectValue(triggerAttrTypes, map[string]attr.Value{
-			"type":        types.StringValue(string(sched.TriggerType)),
-			"event_type":  null(),
-			"interval":    types.StringValue(sched.Interval),
-			"start_after": startAfter,
-			"timestamp":   null(),
-		})
+	current, diags := fetchScriptVersionNumber(ctx, client, scriptID.ValueInt64())
+	if diags.HasError() {
+		return diags
 	}
+	diags.Append(compareScriptVersion(scriptID.ValueInt64(), pinned.ValueInt64(), current)...)
+	return diags
+}
+
+// fetchScriptVersionNumber returns the current version_number of a V2
+// script, or nil if Landscape does not report one.
+func fetchScriptVersionNumber(ctx context.Context, client *landscape.ClientWithResponses, scriptID int64) (*int, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	if ot, err := trigger.AsScriptProfileOneTimeTrigger(); err == nil && string(ot.TriggerType) == "one_time" {
-		return types.ObjectValue(triggerAttrTypes, map[string]attr.Value{
-			"type":        types.StringValue(string(ot.TriggerType)),
-			"event_type":  null(),
-			"interval":    null(),
-			"start_after": null(),
-			"timestamp":   types.StringValue(ot.Timestamp.Format(time.RFC3339)),
-		})
+	res, err := client.GetScriptWithResponse(ctx, int(scriptID))
+	if err != nil {
+		diags.AddError("Failed to read script", err.Error())
+		return nil, diags
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Failed to read script", fmt.Sprintf("Error getting script: %s", res.Status()))
+		return nil, diags
+	}
+	script, err := res.JSON200.AsV2Script()
+	if err != nil {
+		diags.AddError("Failed to convert script", "The script is not a V2 script.")
+		return nil, diags
 	}
+	return script.VersionNumber, diags
+}
 
+// compareScriptVersion reports an error unless current is the pinned version.
+func compareScriptVersion(scriptID, pinned int64, current *int) diag.Diagnostics {
 	var diags diag.Diagnostics
-	diags.AddError("Unknown trigger type", "Could not deserialise trigger from API response")
-	return types.ObjectNull(triggerAttrTypes), diags
+	if current != nil && int64(*current) == pinned {
+		return diags
+	}
+
+	currentStr := "unknown"
+	if current != nil {
+		currentStr = strconv.Itoa(*current)
+	}
+	diags.AddAttributeError(
+		path.Root("script_version"),
+		"Script version mismatch",
+		fmt.Sprintf("Script %d is at version %s, but the profile is pinned to version %d. "+
+			"Update script_version after reviewing the new version, or revert the script.",
+			scriptID, currentStr, pinned),
+	)
+	return diags
+}
+
+// observedScriptVersion is the script_version to store on Read. While a pin
+// is set, the script's current version is stored instead of the pin, so a
+// script that has moved on shows up as a change to script_version in the next
+// plan, and that apply fails the pin check until the pin is updated.
+func observedScriptVersion(pinned types.Int64, current *int) types.Int64 {
+	if pinned.IsNull() || pinned.IsUnknown() {
+		return pinned
+	}
+	if current == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*current))
 }
 
 func errorFromCreateResp(res *landscape.CreateScriptProfileResponse) string {
@@ -5,12 +5,22 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	pfdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
 )
 
 func TestScriptProfileResourceMetadata(t *testing.T) {
@@ -48,14 +58,326 @@ func TestAccScriptProfileResourceMissingTrigger(t *testing.T) {
 	})
 }
 
-func TestAccScriptProfileResourceInvalidTriggerType(t *testing.T) {
+func TestAccScriptProfileResourceConflictingTriggers(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccScriptProfileResourceInvalidTriggerTypeConfig,
-				ExpectError: regexp.MustCompile(`(?i)trigger\.type`),
+				Config:      testAccScriptProfileResourceConflictingTriggersConfig,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestValidateScriptProfileTrigger(t *testing.T) {
+	recurring := func(interval, startAfter, timezone types.String) types.Object {
+		return types.ObjectValueMust(triggerBlockAttrTypes, map[string]attr.Value{
+			"event": types.ObjectNull(eventTriggerAttrTypes),
+			"recurring": types.ObjectValueMust(recurringTriggerAttrTypes, map[string]attr.Value{
+				"interval":    interval,
+				"start_after": startAfter,
+				"timezone":    timezone,
+				"next_run_at": types.StringNull(),
+			}),
+			"one_time": types.ObjectNull(oneTimeTriggerAttrTypes),
+		})
+	}
+	oneTime := func(timestamp types.String) types.Object {
+		return types.ObjectValueMust(triggerBlockAttrTypes, map[string]attr.Value{
+			"event":     types.ObjectNull(eventTriggerAttrTypes),
+			"recurring": types.ObjectNull(recurringTriggerAttrTypes),
+			"one_time":  types.ObjectValueMust(oneTimeTriggerAttrTypes, map[string]attr.Value{"timestamp": timestamp}),
+		})
+	}
+	hourly := types.StringValue("0 * * * *")
+	startAfter := types.StringValue("2030-01-01T00:00:00Z")
+	utc := types.StringNull()
+
+	cases := []struct {
+		name    string
+		trigger types.Object
+		wantErr bool
+	}{
+		{"recurring", recurring(hourly, startAfter, utc), false},
+		{"recurring with bad cron", recurring(types.StringValue("61 * * * *"), startAfter, utc), true},
+		{"recurring with bad start_after", recurring(hourly, types.StringValue("tomorrow"), utc), true},
+		{"recurring with unknown interval", recurring(types.StringUnknown(), startAfter, utc), false},
+		{"recurring with local start_after", recurring(hourly, types.StringValue("2030-01-01T09:00:00"), types.StringValue("Europe/Berlin")), false},
+		{"recurring with local start_after and no timezone", recurring(hourly, types.StringValue("2030-01-01T09:00:00"), utc), true},
+		{"recurring with local start_after and unknown timezone", recurring(hourly, types.StringValue("2030-01-01T09:00:00"), types.StringUnknown()), false},
+		{"recurring with bad timezone", recurring(hourly, startAfter, types.StringValue("Mars/Olympus")), true},
+		{"one_time", oneTime(startAfter), false},
+		{"one_time with bad timestamp", oneTime(types.StringValue("2030-01-01")), true},
+		{"unknown trigger", types.ObjectUnknown(triggerBlockAttrTypes), false},
+	}
+	for _, tc := range cases {
+		diags := validateScriptProfileTrigger(tc.trigger)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error=%v, got %v", tc.name, tc.wantErr, diags)
+		}
+	}
+}
+
+func TestTriggerV0ToBlock(t *testing.T) {
+	flat := func(attrs map[string]string) types.Object {
+		values := map[string]attr.Value{}
+		for name := range triggerAttrTypes {
+			values[name] = types.StringNull()
+		}
+		for name, value := range attrs {
+			values[name] = types.StringValue(value)
+		}
+		return types.ObjectValueMust(triggerAttrTypes, values)
+	}
+
+	block, diags := triggerV0ToBlock(flat(map[string]string{
+		"type":        "recurring",
+		"interval":    "0 * * * *",
+		"start_after": "2030-01-01T00:00:00Z",
+		// Left over from an earlier trigger type; dropped on upgrade.
+		"event_type": "post_enrollment",
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := triggerAttr(block, "recurring", "interval").ValueString(); got != "0 * * * *" {
+		t.Errorf("expected interval to be kept, got %q", got)
+	}
+	if got := triggerAttr(block, "recurring", "start_after").ValueString(); got != "2030-01-01T00:00:00Z" {
+		t.Errorf("expected start_after to be kept, got %q", got)
+	}
+	if !block.Attributes()["event"].IsNull() || !block.Attributes()["one_time"].IsNull() {
+		t.Errorf("expected only recurring to be set, got %v", block)
+	}
+
+	block, diags = triggerV0ToBlock(flat(map[string]string{"type": "event", "event_type": "post_enrollment"}))
+	if diags.HasError() || triggerAttr(block, "event", "event_type").ValueString() != "post_enrollment" {
+		t.Errorf("expected event trigger, got %v (%v)", block, diags)
+	}
+
+	if _, diags := triggerV0ToBlock(flat(map[string]string{"type": "sometimes"})); !diags.HasError() {
+		t.Error("expected an unknown trigger type to be rejected")
+	}
+}
+
+func TestScriptProfileResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &ScriptProfileResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	prior := tfsdk.State{Schema: *upgrader.PriorSchema}
+	prior.Raw = tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil)
+	diags := prior.Set(ctx, scriptProfileResourceModelV0{
+		Id:            types.Int64Value(7),
+		Title:         types.StringValue("nightly"),
+		ScriptId:      types.Int64Value(3),
+		ScriptVersion: types.Int64Null(),
+		AccessGroup:   types.StringValue("global"),
+		Username:      types.StringValue("root"),
+		TimeLimit:     types.Int64Value(300),
+		AllComputers:  types.BoolValue(false),
+		Tags:          types.SetValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+		Archived:      types.BoolValue(false),
+		CreatedAt:     types.StringValue("2026-01-01T00:00:00Z"),
+		LastEditedAt:  types.StringValue("2026-01-01T00:00:00Z"),
+		Trigger: types.ObjectValueMust(triggerAttrTypes, map[string]attr.Value{
+			"type":        types.StringValue("one_time"),
+			"event_type":  types.StringNull(),
+			"interval":    types.StringNull(),
+			"start_after": types.StringNull(),
+			"timestamp":   types.StringValue("2030-01-01T00:00:00Z"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building prior state: %v", diags)
+	}
+
+	var schemaResp pfresource.SchemaResponse
+	r.Schema(ctx, pfresource.SchemaRequest{}, &schemaResp)
+	resp := pfresource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, pfresource.UpgradeStateRequest{State: &prior}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics upgrading state: %v", resp.Diagnostics)
+	}
+
+	var state ScriptProfileResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading upgraded state: %v", diags)
+	}
+	if state.Id.ValueInt64() != 7 || state.Title.ValueString() != "nightly" {
+		t.Errorf("expected id and title to be kept, got %v and %v", state.Id, state.Title)
+	}
+	if got := triggerAttr(state.Trigger, "one_time", "timestamp").ValueString(); got != "2030-01-01T00:00:00Z" {
+		t.Errorf("expected one_time timestamp to be kept, got %q", got)
+	}
+	if !state.LastRunAt.IsNull() || !state.ComputersTargeted.IsNull() {
+		t.Errorf("expected run statistics to be null until the next read, got %v and %v", state.LastRunAt, state.ComputersTargeted)
+	}
+}
+
+func TestParseTriggerTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		value    string
+		timezone string
+		want     time.Time
+		wantErr  bool
+	}{
+		{"RFC3339", "2026-04-01T09:00:00+02:00", "", time.Date(2026, 4, 1, 7, 0, 0, 0, time.UTC), false},
+		{"local without timezone", "2026-04-01T09:00:00", "", time.Time{}, true},
+		{"local with timezone", "2026-04-01T09:00:00", "Europe/Berlin", time.Date(2026, 4, 1, 9, 0, 0, 0, berlin), false},
+		{"RFC3339 with timezone", "2026-04-01T09:00:00Z", "Europe/Berlin", time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC), false},
+		{"garbage with timezone", "tomorrow", "Europe/Berlin", time.Time{}, true},
+		{"unknown timezone", "2026-04-01T09:00:00", "Mars/Olympus", time.Time{}, true},
+	}
+	for _, tc := range cases {
+		got, diags := parseTriggerTime("start_after", tc.value, tc.timezone)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error=%v, got %v", tc.name, tc.wantErr, diags)
+			continue
+		}
+		if !tc.wantErr && !got.Equal(tc.want) {
+			t.Errorf("%s: expected %s, got %s", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestTriggerResponseToBlockKeepsEquivalentTimes(t *testing.T) {
+	var response landscape.ScriptProfileTriggerResponse
+	err := response.UnmarshalJSON([]byte(`{
+		"trigger_type": "recurring",
+		"interval": "0 9 * * *",
+		"start_after": "2026-04-01T07:00:00Z",
+		"next_run": "2026-04-02T07:00:00Z"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recurring := func(startAfter, timezone types.String) types.Object {
+		return types.ObjectValueMust(triggerBlockAttrTypes, map[string]attr.Value{
+			"event": types.ObjectNull(eventTriggerAttrTypes),
+			"recurring": types.ObjectValueMust(recurringTriggerAttrTypes, map[string]attr.Value{
+				"interval":    types.StringValue("0 9 * * *"),
+				"start_after": startAfter,
+				"timezone":    timezone,
+				"next_run_at": types.StringUnknown(),
+			}),
+			"one_time": types.ObjectNull(oneTimeTriggerAttrTypes),
+		})
+	}
+
+	cases := []struct {
+		name           string
+		prior          types.Object
+		wantStartAfter string
+		wantNextRunAt  string
+	}{
+		{"no prior", types.ObjectNull(triggerBlockAttrTypes), "2026-04-01T07:00:00Z", "2026-04-02T07:00:00Z"},
+		{"same instant with offset", recurring(types.StringValue("2026-04-01T09:00:00+02:00"), types.StringNull()), "2026-04-01T09:00:00+02:00", "2026-04-02T07:00:00Z"},
+		{"same instant in timezone", recurring(types.StringValue("2026-04-01T09:00:00"), types.StringValue("Europe/Berlin")), "2026-04-01T09:00:00", "2026-04-02T09:00:00+02:00"},
+		{"changed outside Terraform", recurring(types.StringValue("2026-03-01T00:00:00Z"), types.StringNull()), "2026-04-01T07:00:00Z", "2026-04-02T07:00:00Z"},
+	}
+	for _, tc := range cases {
+		block, diags := triggerResponseToBlock(response, tc.prior)
+		if diags.HasError() {
+			t.Errorf("%s: unexpected diagnostics: %v", tc.name, diags)
+			continue
+		}
+		if got := triggerAttr(block, "recurring", "start_after").ValueString(); got != tc.wantStartAfter {
+			t.Errorf("%s: expected start_after %q, got %q", tc.name, tc.wantStartAfter, got)
+		}
+		if got := triggerAttr(block, "recurring", "next_run_at").ValueString(); got != tc.wantNextRunAt {
+			t.Errorf("%s: expected next_run_at %q, got %q", tc.name, tc.wantNextRunAt, got)
+		}
+	}
+}
+
+func TestValidateCronInterval(t *testing.T) {
+	valid := []string{
+		"0 * * * *",
+		"*/15 6-18 * * mon-fri",
+		"30 2 1,15 * 0",
+		"0 0 * jan,jul 7",
+		"@daily",
+		"@hourly",
+	}
+	for _, interval := range valid {
+		if diags := validateCronInterval(interval); diags.HasError() {
+			t.Errorf("expected %q to be valid, got %v", interval, diags)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"0 * * *",
+		"60 * * * *",
+		"0 24 * * *",
+		"0 0 0 * *",
+		"*/0 * * * *",
+		"0 0 * 13 *",
+		"0 0 * * 8",
+		"@fortnightly",
+	}
+	for _, interval := range invalid {
+		if diags := validateCronInterval(interval); !diags.HasError() {
+			t.Errorf("expected %q to be rejected", interval)
+		}
+	}
+}
+
+func TestValidateOneTimeTimestamp(t *testing.T) {
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := types.StringValue("2030-01-01T11:00:00Z")
+	future := types.StringValue("2030-01-01T13:00:00Z")
+
+	cases := []struct {
+		name    string
+		planned types.String
+		prior   types.String
+		wantErr bool
+	}{
+		{"future", future, types.StringNull(), false},
+		{"past", past, types.StringNull(), true},
+		{"now", types.StringValue("2030-01-01T12:00:00Z"), types.StringNull(), true},
+		{"past and unchanged", past, past, false},
+		{"past in another zone and unchanged", types.StringValue("2030-01-01T12:00:00+01:00"), past, false},
+		{"changed to another past time", past, types.StringValue("2029-12-31T00:00:00Z"), true},
+		{"unknown", types.StringUnknown(), types.StringNull(), false},
+		{"unset", types.StringNull(), past, false},
+	}
+	for _, tc := range cases {
+		diags := validateOneTimeTimestamp(tc.planned, tc.prior, now)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error=%v, got %v", tc.name, tc.wantErr, diags)
+		}
+	}
+}
+
+func TestAccScriptProfileResourceInvalidTriggerFields(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptProfileResourceRecurringWithoutIntervalConfig,
+				ExpectError: regexp.MustCompile(`(?i)"interval" is required`),
+			},
+			{
+				Config:      testAccScriptProfileResourcePastOneTimeConfig,
+				ExpectError: regexp.MustCompile(`(?i)in the past`),
 			},
 		},
 	})
@@ -72,7 +394,42 @@ resource "landscape_script_profile" "test" {
 }
 `
 
-const testAccScriptProfileResourceInvalidTriggerTypeConfig = `
+const testAccScriptProfileResourceConflictingTriggersConfig = `
+provider "landscape" {}
+
+resource "landscape_script_profile" "test" {
+  title      = "Test Script Profile"
+  script_id  = 1
+  username   = "root"
+  time_limit = 600
+  trigger = {
+    event = {
+      event_type = "post_enrollment"
+    }
+    one_time = {
+      timestamp = "2030-01-01T00:00:00Z"
+    }
+  }
+}
+`
+
+const testAccScriptProfileResourceRecurringWithoutIntervalConfig = `
+provider "landscape" {}
+
+resource "landscape_script_profile" "test" {
+  title      = "Test Script Profile"
+  script_id  = 1
+  username   = "root"
+  time_limit = 600
+  trigger = {
+    recurring = {
+      start_after = "2030-01-01T00:00:00Z"
+    }
+  }
+}
+`
+
+const testAccScriptProfileResourcePastOneTimeConfig = `
 provider "landscape" {}
 
 resource "landscape_script_profile" "test" {
@@ -81,7 +438,223 @@ resource "landscape_script_profile" "test" {
   username   = "root"
   time_limit = 600
   trigger = {
-    type = "invalid_type"
+    one_time = {
+      timestamp = "2000-01-01T00:00:00Z"
+    }
   }
 }
 `
+
+func TestAccScriptProfileDataSourceRequiresIDOrTitle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptProfileDataSourceEmptyConfig,
+				ExpectError: regexp.MustCompile(`(?i)title`),
+			},
+			{
+				Config:      testAccScriptProfileDataSourceIDAndTitleConfig,
+				ExpectError: regexp.MustCompile(`(?i)title`),
+			},
+		},
+	})
+}
+
+const testAccScriptProfileDataSourceEmptyConfig = `
+provider "landscape" {}
+
+data "landscape_script_profile" "test" {}
+`
+
+const testAccScriptProfileDataSourceIDAndTitleConfig = `
+provider "landscape" {}
+
+data "landscape_script_profile" "test" {
+  id    = 1
+  title = "nightly-backup"
+}
+`
+
+func TestScriptProfileRunStatsFrom(t *testing.T) {
+	detail := func(body string) landscape.ScriptProfileDetail {
+		var d landscape.ScriptProfileDetail
+		if err := json.Unmarshal([]byte(body), &d); err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	activity := func(status, created string) legacyActivity {
+		return legacyActivity{ActivityStatus: status, CreationTime: &created}
+	}
+
+	recurring := detail(`{
+		"id": 1,
+		"computers": {"num_associated_computers": 4},
+		"trigger": {
+			"trigger_type": "recurring",
+			"interval": "0 9 * * *",
+			"start_after": "2026-04-01T07:00:00Z",
+			"last_run": "2026-04-02T09:00:00+02:00",
+			"next_run": "2026-04-03T07:00:00Z"
+		}
+	}`)
+	event := detail(`{
+		"id": 2,
+		"computers": {"num_associated_computers": 2},
+		"trigger": {"trigger_type": "event", "event_type": "post_enrollment"}
+	}`)
+
+	t.Run("not run", func(t *testing.T) {
+		stats := scriptProfileRunStatsFrom(event, nil, false)
+		if !stats.LastRunAt.IsNull() || !stats.NextRunAt.IsNull() {
+			t.Errorf("expected null run times, got %v and %v", stats.LastRunAt, stats.NextRunAt)
+		}
+		if !stats.LastRunSucceeded.IsNull() || !stats.LastRunFailed.IsNull() {
+			t.Errorf("expected null counts, got %v and %v", stats.LastRunSucceeded, stats.LastRunFailed)
+		}
+		if stats.ComputersTargeted.ValueInt64() != 2 {
+			t.Errorf("expected 2 computers targeted, got %v", stats.ComputersTargeted)
+		}
+	})
+
+	t.Run("recurring", func(t *testing.T) {
+		stats := scriptProfileRunStatsFrom(recurring, []legacyActivity{
+			activity(activityStatusSucceeded, "2026-04-02T07:00:01Z"),
+			activity(activityStatusSucceeded, "2026-04-02T07:00:01Z"),
+			activity(activityStatusFailed, "2026-04-02T07:00:01Z"),
+			activity(activityStatusCanceled, "2026-04-02T07:00:01Z"),
+			activity("delivered", "2026-04-02T07:00:01Z"),
+		}, true)
+		if got := stats.LastRunAt.ValueString(); got != "2026-04-02T07:00:00Z" {
+			t.Errorf("expected last_run_at from the trigger, got %q", got)
+		}
+		if got := stats.NextRunAt.ValueString(); got != "2026-04-03T07:00:00Z" {
+			t.Errorf("expected next_run_at from the trigger, got %q", got)
+		}
+		if stats.LastRunSucceeded.ValueInt64() != 2 || stats.LastRunFailed.ValueInt64() != 2 {
+			t.Errorf("expected 2 succeeded and 2 failed, got %v and %v", stats.LastRunSucceeded, stats.LastRunFailed)
+		}
+		if stats.ComputersTargeted.ValueInt64() != 4 {
+			t.Errorf("expected 4 computers targeted, got %v", stats.ComputersTargeted)
+		}
+	})
+
+	t.Run("event", func(t *testing.T) {
+		stats := scriptProfileRunStatsFrom(event, []legacyActivity{
+			activity(activityStatusSucceeded, "2026-04-02T07:00:05"),
+			activity(activityStatusSucceeded, "2026-04-02T07:00:03"),
+		}, true)
+		if got := stats.LastRunAt.ValueString(); got != "2026-04-02T07:00:03Z" {
+			t.Errorf("expected last_run_at from the first activity, got %q", got)
+		}
+		if !stats.NextRunAt.IsNull() {
+			t.Errorf("expected null next_run_at, got %v", stats.NextRunAt)
+		}
+	})
+
+	t.Run("run without activities", func(t *testing.T) {
+		stats := scriptProfileRunStatsFrom(event, nil, true)
+		if stats.LastRunSucceeded.ValueInt64() != 0 || stats.LastRunFailed.ValueInt64() != 0 {
+			t.Errorf("expected zero counts, got %v and %v", stats.LastRunSucceeded, stats.LastRunFailed)
+		}
+	})
+}
+
+func TestFetchScriptProfileRunStatsWarnsOnError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := landscape.NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var detail landscape.ScriptProfileDetail
+	if err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"activities": {"last_activity": {"id": 42}},
+		"computers": {"num_associated_computers": 3},
+		"trigger": {"trigger_type": "event", "event_type": "post_enrollment"}
+	}`), &detail); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, diags := fetchScriptProfileRunStats(context.Background(), client, detail)
+	if diags.HasError() {
+		t.Fatalf("expected only warnings, got %v", diags)
+	}
+	if diags.WarningsCount() == 0 {
+		t.Error("expected a warning")
+	}
+	if !stats.LastRunSucceeded.IsNull() || !stats.LastRunFailed.IsNull() {
+		t.Errorf("expected null counts, got %v and %v", stats.LastRunSucceeded, stats.LastRunFailed)
+	}
+	if stats.ComputersTargeted.ValueInt64() != 3 {
+		t.Errorf("expected 3 computers targeted, got %v", stats.ComputersTargeted)
+	}
+}
+
+func TestErrorsAsWarnings(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddWarning("Existing warning", "kept")
+	diags.AddError("Failed to read script", "status 403")
+
+	got := errorsAsWarnings(diags, "Failed to check script version", "The pin is kept.")
+	if got.HasError() {
+		t.Fatalf("expected no errors, got %v", got)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", got)
+	}
+	if got[1].Summary() != "Failed to check script version" || got[1].Detail() != "The pin is kept. Failed to read script: status 403" {
+		t.Errorf("unexpected warning %q: %q", got[1].Summary(), got[1].Detail())
+	}
+}
+
+func TestLastRunID(t *testing.T) {
+	if _, ok := lastRunID(landscape.ScriptProfileActivityInfo{}); ok {
+		t.Error("expected no run ID without a last activity")
+	}
+	if _, ok := lastRunID(landscape.ScriptProfileActivityInfo{LastActivity: &map[string]interface{}{"status": "succeeded"}}); ok {
+		t.Error("expected no run ID without an id")
+	}
+	id, ok := lastRunID(landscape.ScriptProfileActivityInfo{LastActivity: &map[string]interface{}{"id": float64(42)}})
+	if !ok || id != 42 {
+		t.Errorf("expected run ID 42, got %d (%v)", id, ok)
+	}
+}
+
+func TestCompareScriptVersion(t *testing.T) {
+	three, four := 3, 4
+	cases := []struct {
+		name    string
+		current *int
+		wantErr bool
+	}{
+		{"matches", &three, false},
+		{"moved on", &four, true},
+		{"unknown", nil, true},
+	}
+	for _, tc := range cases {
+		if diags := compareScriptVersion(1, 3, tc.current); diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error=%v, got %v", tc.name, tc.wantErr, diags)
+		}
+	}
+}
+
+func TestObservedScriptVersion(t *testing.T) {
+	four := 4
+	if got := observedScriptVersion(types.Int64Null(), &four); !got.IsNull() {
+		t.Errorf("expected no pin to stay null, got %v", got)
+	}
+	if got := observedScriptVersion(types.Int64Value(3), &four); got.ValueInt64() != 4 {
+		t.Errorf("expected the current version to be stored, got %v", got)
+	}
+	if got := observedScriptVersion(types.Int64Value(3), nil); !got.IsNull() {
+		t.Errorf("expected null when the script has no version, got %v", got)
+	}
+}
@@ -0,0 +1,660 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	// Embed the time zone database so `timezone` works on hosts without one.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+// scriptProfileEventTypes are the events an `event` trigger can run on.
+var scriptProfileEventTypes = []string{string(landscape.PostEnrollment)}
+
+// triggerAttrTypes is the flat trigger object of the script profile data
+// sources, and of version 0 of the resource: one set of optional strings
+// discriminated by `type`.
+var triggerAttrTypes = map[string]attr.Type{
+	"type":        types.StringType,
+	"event_type":  types.StringType,
+	"interval":    types.StringType,
+	"start_after": types.StringType,
+	"timestamp":   types.StringType,
+}
+
+var (
+	eventTriggerAttrTypes = map[string]attr.Type{
+		"event_type": types.StringType,
+	}
+	recurringTriggerAttrTypes = map[string]attr.Type{
+		"interval":    types.StringType,
+		"start_after": types.StringType,
+		"timezone":    types.StringType,
+		"next_run_at": types.StringType,
+	}
+	oneTimeTriggerAttrTypes = map[string]attr.Type{
+		"timestamp": types.StringType,
+	}
+
+	// triggerBlockAttrTypes is the resource's trigger object, in which
+	// exactly one of the nested objects is set.
+	triggerBlockAttrTypes = map[string]attr.Type{
+		"event":     types.ObjectType{AttrTypes: eventTriggerAttrTypes},
+		"recurring": types.ObjectType{AttrTypes: recurringTriggerAttrTypes},
+		"one_time":  types.ObjectType{AttrTypes: oneTimeTriggerAttrTypes},
+	}
+)
+
+type scriptProfileTriggerModel struct {
+	Event     types.Object `tfsdk:"event"`
+	Recurring types.Object `tfsdk:"recurring"`
+	OneTime   types.Object `tfsdk:"one_time"`
+}
+
+type scriptProfileEventTriggerModel struct {
+	EventType types.String `tfsdk:"event_type"`
+}
+
+type scriptProfileRecurringTriggerModel struct {
+	Interval   types.String `tfsdk:"interval"`
+	StartAfter types.String `tfsdk:"start_after"`
+	Timezone   types.String `tfsdk:"timezone"`
+	NextRunAt  types.String `tfsdk:"next_run_at"`
+}
+
+type scriptProfileOneTimeTriggerModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+// localDateTimeLayout is a datetime without a UTC offset, accepted for
+// `start_after` when the recurring trigger sets a timezone.
+const localDateTimeLayout = "2006-01-02T15:04:05"
+
+// scriptProfileTriggerSchema is the resource's trigger attribute. Each kind of
+// trigger is its own nested object so that only its attributes can be set.
+func scriptProfileTriggerSchema() resourceschema.SingleNestedAttribute {
+	exactlyOne := objectvalidator.ExactlyOneOf(
+		path.MatchRelative().AtParent().AtName("event"),
+		path.MatchRelative().AtParent().AtName("recurring"),
+		path.MatchRelative().AtParent().AtName("one_time"),
+	)
+
+	return resourceschema.SingleNestedAttribute{
+		Required:            true,
+		MarkdownDescription: "The trigger that controls when the script profile executes. Set exactly one of `event`, `recurring` or `one_time`.",
+		Attributes: map[string]resourceschema.Attribute{
+			"event": resourceschema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Run the script when an event occurs.",
+				Validators:          []validator.Object{exactlyOne},
+				Attributes: map[string]resourceschema.Attribute{
+					"event_type": resourceschema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The event type. Currently only `post_enrollment`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(scriptProfileEventTypes...),
+						},
+					},
+				},
+			},
+			"recurring": resourceschema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Run the script on a cron schedule.",
+				Validators:          []validator.Object{exactlyOne},
+				Attributes: map[string]resourceschema.Attribute{
+					"interval": resourceschema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Cron expression (e.g. `0 * * * *`) or macro (e.g. `@daily`).",
+					},
+					"start_after": resourceschema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "RFC3339 datetime after which the schedule begins. When `timezone` is set, a local datetime without an offset (e.g. `2026-04-01T09:00:00`) is also accepted and read in that zone. A value denoting the same instant as Landscape's is kept as written.",
+					},
+					"timezone": resourceschema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "IANA time zone (e.g. `Europe/Berlin`) in which `start_after` is read when it has no offset, and in which `next_run_at` is reported. Defaults to UTC. Landscape does not store it, so it is not set on import.",
+					},
+					"next_run_at": resourceschema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "When Landscape will next run the profile (RFC3339), in `timezone` if set.",
+					},
+				},
+			},
+			"one_time": resourceschema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Run the script once.",
+				Validators:          []validator.Object{exactlyOne},
+				Attributes: map[string]resourceschema.Attribute{
+					"timestamp": resourceschema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "RFC3339 datetime at which the profile executes. Must be in the future when set or changed.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateScriptProfileTrigger checks the values the schema cannot: the cron
+// expression, the timezone and the datetimes. Unknown values are skipped so
+// it can run from ValidateConfig.
+func validateScriptProfileTrigger(trigger types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	recurringPath := path.Root("trigger").AtName("recurring")
+
+	if interval := triggerAttr(trigger, "recurring", "interval"); !interval.IsNull() && !interval.IsUnknown() {
+		diags.Append(withAttributePath(recurringPath.AtName("interval"), validateCronInterval(interval.ValueString()))...)
+	}
+
+	timezone := triggerAttr(trigger, "recurring", "timezone")
+	if !timezone.IsNull() && !timezone.IsUnknown() {
+		if _, err := time.LoadLocation(timezone.ValueString()); err != nil || timezone.ValueString() == "" {
+			diags.AddAttributeError(recurringPath.AtName("timezone"), "Invalid timezone",
+				fmt.Sprintf("Must be an IANA time zone name such as Europe/Berlin, got %q.", timezone.ValueString()))
+			timezone = types.StringNull()
+		}
+	}
+	if startAfter := triggerAttr(trigger, "recurring", "start_after"); !startAfter.IsNull() && !startAfter.IsUnknown() && !timezone.IsUnknown() {
+		_, d := parseTriggerTime("start_after", startAfter.ValueString(), timezone.ValueString())
+		diags.Append(withAttributePath(recurringPath.AtName("start_after"), d)...)
+	}
+
+	if timestamp := triggerAttr(trigger, "one_time", "timestamp"); !timestamp.IsNull() && !timestamp.IsUnknown() {
+		_, d := parseScheduleTimestamp("timestamp", timestamp.ValueString())
+		diags.Append(withAttributePath(path.Root("trigger").AtName("one_time").AtName("timestamp"), d)...)
+	}
+	return diags
+}
+
+// cronFields are the fields of a recurring trigger's cron expression.
+var cronFields = []scheduleField{
+	scheduleMinute,
+	scheduleHour,
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// cronMacros are the shorthand schedules cron accepts in place of the five
+// fields.
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// validateCronInterval checks that interval is a cron macro or a five-field
+// cron expression whose numeric values fall within each field's range.
+func validateCronInterval(interval string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if strings.HasPrefix(interval, "@") {
+		if !slices.Contains(cronMacros, interval) {
+			diags.AddError("Invalid interval",
+				fmt.Sprintf("Unknown cron macro %q; use one of %s or a five-field expression.", interval, strings.Join(cronMacros, ", ")))
+		}
+		return diags
+	}
+
+	fields := strings.Fields(interval)
+	if len(fields) != len(cronFields) {
+		diags.AddError("Invalid interval",
+			fmt.Sprintf("Must be a cron expression with %d fields, got %q.", len(cronFields), interval))
+		return diags
+	}
+	for i, field := range fields {
+		if err := cronFields[i].validate(field); err != nil {
+			diags.AddError("Invalid interval", fmt.Sprintf("%q: %s", interval, err))
+		}
+	}
+	return diags
+}
+
+// validate checks a single cron field: a comma-separated list of `*`, values
+// or ranges, each optionally followed by a `/step`. Named months and weekdays
+// are accepted as-is.
+func (f scheduleField) validate(expr string) error {
+	for _, part := range strings.Split(expr, ",") {
+		rangePart, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if n, err := strconv.ParseInt(step, 10, 64); err != nil || n < 1 {
+				return fmt.Errorf("invalid %s step %q", f.name, step)
+			}
+		}
+		if rangePart == "*" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(rangePart, "-")
+		bounds := []string{lo}
+		if isRange {
+			bounds = append(bounds, hi)
+		}
+		for _, b := range bounds {
+			if b != "" && isAlpha(b) {
+				continue
+			}
+			if err := f.check(b); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f scheduleField) check(value string) error {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < f.min || n > f.max {
+		return fmt.Errorf("%s %q must be between %d and %d", f.name, value, f.min, f.max)
+	}
+	return nil
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// validateOneTimeTimestamp rejects a planned one_time timestamp that is not
+// after now, unless it is the timestamp already in state.
+func validateOneTimeTimestamp(planned, prior types.String, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if planned.IsNull() || planned.IsUnknown() {
+		return diags
+	}
+	ts, err := time.Parse(time.RFC3339, planned.ValueString())
+	if err != nil {
+		// ValidateConfig reports unparsable timestamps.
+		return diags
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if priorTS, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && priorTS.Equal(ts) {
+			return diags
+		}
+	}
+	if !ts.After(now) {
+		diags.AddAttributeError(path.Root("trigger").AtName("one_time").AtName("timestamp"), "Invalid trigger",
+			fmt.Sprintf("`timestamp` %s is in the past; one_time triggers must be scheduled in the future.", planned.ValueString()))
+	}
+	return diags
+}
+
+// parseTriggerTime parses a trigger datetime. Without a timezone it must be
+// RFC3339; with one, a local datetime without an offset is read in that zone.
+func parseTriggerTime(attr, value, timezone string) (time.Time, diag.Diagnostics) {
+	if timezone == "" {
+		return parseScheduleTimestamp(attr, value)
+	}
+
+	var diags diag.Diagnostics
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return ts, diags
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		diags.AddError("Invalid timezone", fmt.Sprintf("Must be an IANA time zone name such as Europe/Berlin: %s", err))
+		return time.Time{}, diags
+	}
+	ts, err := time.ParseInLocation(localDateTimeLayout, value, loc)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid %s", attr),
+			fmt.Sprintf("Must be RFC3339, or a local datetime such as 2026-04-01T09:00:00 read in %s: %s", timezone, err))
+	}
+	return ts, diags
+}
+
+// triggerAttr returns a string attribute of one kind of trigger, or null
+// when the trigger or that kind is not set.
+func triggerAttr(trigger types.Object, kind, name string) types.String {
+	if trigger.IsNull() || trigger.IsUnknown() {
+		return types.StringNull()
+	}
+	nested, ok := trigger.Attributes()[kind].(types.Object)
+	if !ok || nested.IsNull() || nested.IsUnknown() {
+		return types.StringNull()
+	}
+	value, ok := nested.Attributes()[name].(types.String)
+	if !ok {
+		return types.StringNull()
+	}
+	return value
+}
+
+// withAttributePath attaches p to diagnostics reported without a path.
+func withAttributePath(p path.Path, diags diag.Diagnostics) diag.Diagnostics {
+	out := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		out = append(out, diag.WithPath(p, d))
+	}
+	return out
+}
+
+func planTriggerToCreateRequest(ctx context.Context, triggerObj types.Object) (landscape.ScriptProfileTriggerCreateRequest, diag.Diagnostics) {
+	var t landscape.ScriptProfileTriggerCreateRequest
+	var trigger scriptProfileTriggerModel
+	diags := triggerObj.As(ctx, &trigger, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return t, diags
+	}
+
+	switch {
+	case !trigger.Event.IsNull():
+		var event scriptProfileEventTriggerModel
+		diags.Append(trigger.Event.As(ctx, &event, basetypes.ObjectAsOptions{})...)
+		err := t.FromScriptProfileEventTrigger(landscape.ScriptProfileEventTrigger{
+			TriggerType: landscape.Event,
+			EventType:   landscape.ScriptProfileEventType(event.EventType.ValueString()),
+		})
+		if err != nil {
+			diags.AddError("Failed to build event trigger", err.Error())
+		}
+	case !trigger.Recurring.IsNull():
+		var recurring scriptProfileRecurringTriggerModel
+		diags.Append(trigger.Recurring.As(ctx, &recurring, basetypes.ObjectAsOptions{})...)
+		startAfter, d := parseTriggerTime("start_after", recurring.StartAfter.ValueString(), recurring.Timezone.ValueString())
+		diags.Append(d...)
+		if diags.HasError() {
+			return t, diags
+		}
+		err := t.FromScriptProfileScheduleDraftTrigger(landscape.ScriptProfileScheduleDraftTrigger{
+			TriggerType: landscape.ScriptProfileScheduleDraftTriggerTriggerTypeRecurring,
+			Interval:    recurring.Interval.ValueString(),
+			StartAfter:  startAfter,
+		})
+		if err != nil {
+			diags.AddError("Failed to build recurring trigger", err.Error())
+		}
+	case !trigger.OneTime.IsNull():
+		var oneTime scriptProfileOneTimeTriggerModel
+		diags.Append(trigger.OneTime.As(ctx, &oneTime, basetypes.ObjectAsOptions{})...)
+		ts, d := parseScheduleTimestamp("timestamp", oneTime.Timestamp.ValueString())
+		diags.Append(d...)
+		if diags.HasError() {
+			return t, diags
+		}
+		err := t.FromScriptProfileOneTimeDraftTrigger(landscape.ScriptProfileOneTimeDraftTrigger{
+			TriggerType: landscape.ScriptProfileOneTimeDraftTriggerTriggerTypeOneTime,
+			Timestamp:   ts,
+		})
+		if err != nil {
+			diags.AddError("Failed to build one_time trigger", err.Error())
+		}
+	default:
+		diags.AddError("Invalid trigger", "Exactly one of event, recurring or one_time must be set.")
+	}
+	return t, diags
+}
+
+func planTriggerToPatchRequest(ctx context.Context, triggerObj types.Object) (*landscape.ScriptProfileTriggerPatchRequest, diag.Diagnostics) {
+	var t landscape.ScriptProfileTriggerPatchRequest
+	var trigger scriptProfileTriggerModel
+	diags := triggerObj.As(ctx, &trigger, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch {
+	case !trigger.Event.IsNull():
+		var event scriptProfileEventTriggerModel
+		diags.Append(trigger.Event.As(ctx, &event, basetypes.ObjectAsOptions{})...)
+		err := t.FromScriptProfileEventTrigger(landscape.ScriptProfileEventTrigger{
+			TriggerType: landscape.Event,
+			EventType:   landscape.ScriptProfileEventType(event.EventType.ValueString()),
+		})
+		if err != nil {
+			diags.AddError("Failed to build event trigger", err.Error())
+		}
+	case !trigger.Recurring.IsNull():
+		var recurring scriptProfileRecurringTriggerModel
+		diags.Append(trigger.Recurring.As(ctx, &recurring, basetypes.ObjectAsOptions{})...)
+		interval := recurring.Interval.ValueString()
+		startAfter, d := parseTriggerTime("start_after", recurring.StartAfter.ValueString(), recurring.Timezone.ValueString())
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		err := t.FromScriptProfileScheduleDraftEditTrigger(landscape.ScriptProfileScheduleDraftEditTrigger{
+			TriggerType: landscape.ScriptProfileScheduleDraftEditTriggerTriggerTypeRecurring,
+			Interval:    &interval,
+			StartAfter:  &startAfter,
+		})
+		if err != nil {
+			diags.AddError("Failed to build recurring trigger", err.Error())
+		}
+	case !trigger.OneTime.IsNull():
+		var oneTime scriptProfileOneTimeTriggerModel
+		diags.Append(trigger.OneTime.As(ctx, &oneTime, basetypes.ObjectAsOptions{})...)
+		ts, d := parseScheduleTimestamp("timestamp", oneTime.Timestamp.ValueString())
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		err := t.FromScriptProfileOneTimeDraftTrigger(landscape.ScriptProfileOneTimeDraftTrigger{
+			TriggerType: landscape.ScriptProfileOneTimeDraftTriggerTriggerTypeOneTime,
+			Timestamp:   ts,
+		})
+		if err != nil {
+			diags.AddError("Failed to build one_time trigger", err.Error())
+		}
+	default:
+		diags.AddError("Invalid trigger", "Exactly one of event, recurring or one_time must be set.")
+	}
+	return &t, diags
+}
+
+// decodeTrigger returns the concrete trigger in a response: a
+// ScriptProfileEventTrigger, ScriptProfileScheduleTrigger or
+// ScriptProfileOneTimeTrigger, chosen by its trigger_type.
+func decodeTrigger(trigger landscape.ScriptProfileTriggerResponse) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	value, err := trigger.ValueByDiscriminator()
+	if err != nil {
+		diags.AddError("Unknown trigger type", fmt.Sprintf("Could not deserialise trigger from API response: %s", err))
+	}
+	return value, diags
+}
+
+// triggerResponseToBlock converts a response trigger to the resource's nested
+// trigger object. Landscape normalises datetimes, e.g. to UTC, so a prior
+// value from the plan or state that denotes the same instant is kept as
+// written. The timezone is not stored by Landscape and is taken from prior.
+func triggerResponseToBlock(trigger landscape.ScriptProfileTriggerResponse, prior types.Object) (types.Object, diag.Diagnostics) {
+	value, diags := decodeTrigger(trigger)
+	if diags.HasError() {
+		return types.ObjectNull(triggerBlockAttrTypes), diags
+	}
+
+	block := map[string]attr.Value{
+		"event":     types.ObjectNull(eventTriggerAttrTypes),
+		"recurring": types.ObjectNull(recurringTriggerAttrTypes),
+		"one_time":  types.ObjectNull(oneTimeTriggerAttrTypes),
+	}
+	var d diag.Diagnostics
+	switch t := value.(type) {
+	case landscape.ScriptProfileEventTrigger:
+		block["event"], d = types.ObjectValue(eventTriggerAttrTypes, map[string]attr.Value{
+			"event_type": types.StringValue(string(t.EventType)),
+		})
+	case landscape.ScriptProfileScheduleTrigger:
+		timezone := triggerAttr(prior, "recurring", "timezone")
+		if timezone.IsUnknown() {
+			timezone = types.StringNull()
+		}
+		loc := time.UTC
+		if !timezone.IsNull() {
+			if l, err := time.LoadLocation(timezone.ValueString()); err == nil {
+				loc = l
+			}
+		}
+		nextRunAt := types.StringNull()
+		if t.NextRun != nil {
+			nextRunAt = formatTriggerTime(t.NextRun.In(loc))
+		}
+		block["recurring"], d = types.ObjectValue(recurringTriggerAttrTypes, map[string]attr.Value{
+			"interval":    types.StringValue(t.Interval),
+			"start_after": keepSameInstant(triggerAttr(prior, "recurring", "start_after"), timezone.ValueString(), formatTriggerTime(t.StartAfter.In(loc))),
+			"timezone":    timezone,
+			"next_run_at": nextRunAt,
+		})
+	case landscape.ScriptProfileOneTimeTrigger:
+		block["one_time"], d = types.ObjectValue(oneTimeTriggerAttrTypes, map[string]attr.Value{
+			"timestamp": keepSameInstant(triggerAttr(prior, "one_time", "timestamp"), "", formatTriggerTime(t.Timestamp)),
+		})
+	}
+	diags.Append(d...)
+
+	obj, d := types.ObjectValue(triggerBlockAttrTypes, block)
+	diags.Append(d...)
+	return obj, diags
+}
+
+// triggerResponseToObject converts a response trigger to the flat trigger
+// object of the data sources.
+func triggerResponseToObject(trigger landscape.ScriptProfileTriggerResponse) (types.Object, diag.Diagnostics) {
+	value, diags := decodeTrigger(trigger)
+	if diags.HasError() {
+		return types.ObjectNull(triggerAttrTypes), diags
+	}
+
+	attrs := map[string]attr.Value{
+		"type":        types.StringNull(),
+		"event_type":  types.StringNull(),
+		"interval":    types.StringNull(),
+		"start_after": types.StringNull(),
+		"timestamp":   types.StringNull(),
+	}
+	switch t := value.(type) {
+	case landscape.ScriptProfileEventTrigger:
+		attrs["type"] = types.StringValue(string(t.TriggerType))
+		attrs["event_type"] = types.StringValue(string(t.EventType))
+	case landscape.ScriptProfileScheduleTrigger:
+		attrs["type"] = types.StringValue(string(t.TriggerType))
+		attrs["interval"] = types.StringValue(t.Interval)
+		attrs["start_after"] = formatTriggerTime(t.StartAfter)
+	case landscape.ScriptProfileOneTimeTrigger:
+		attrs["type"] = types.StringValue(string(t.TriggerType))
+		attrs["timestamp"] = formatTriggerTime(t.Timestamp)
+	}
+	obj, d := types.ObjectValue(triggerAttrTypes, attrs)
+	diags.Append(d...)
+	return obj, diags
+}
+
+// formatTriggerTime formats a trigger time as RFC3339, or null when unset.
+func formatTriggerTime(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// scriptProfileResourceModelV0 is the resource state before the trigger was
+// split into nested objects.
+type scriptProfileResourceModelV0 struct {
+	Id            types.Int64  `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	ScriptId      types.Int64  `tfsdk:"script_id"`
+	ScriptVersion types.Int64  `tfsdk:"script_version"`
+	AccessGroup   types.String `tfsdk:"access_group"`
+	Username      types.String `tfsdk:"username"`
+	TimeLimit     types.Int64  `tfsdk:"time_limit"`
+	AllComputers  types.Bool   `tfsdk:"all_computers"`
+	Tags          types.Set    `tfsdk:"tags"`
+	Archived      types.Bool   `tfsdk:"archived"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	LastEditedAt  types.String `tfsdk:"last_edited_at"`
+	Trigger       types.Object `tfsdk:"trigger"`
+}
+
+// scriptProfileSchemaV0 is the version 0 resource schema, used to decode
+// state written before the nested trigger.
+func scriptProfileSchemaV0() resourceschema.Schema {
+	triggerAttrs := map[string]resourceschema.Attribute{}
+	for name := range triggerAttrTypes {
+		triggerAttrs[name] = resourceschema.StringAttribute{Optional: true}
+	}
+
+	return resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			"id":             resourceschema.Int64Attribute{Computed: true},
+			"title":          resourceschema.StringAttribute{Required: true},
+			"script_id":      resourceschema.Int64Attribute{Required: true},
+			"script_version": resourceschema.Int64Attribute{Optional: true},
+			"username":       resourceschema.StringAttribute{Required: true},
+			"time_limit":     resourceschema.Int64Attribute{Required: true},
+			"all_computers":  resourceschema.BoolAttribute{Optional: true, Computed: true},
+			"tags":           resourceschema.SetAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+			"access_group":   resourceschema.StringAttribute{Computed: true},
+			"archived":       resourceschema.BoolAttribute{Computed: true},
+			"created_at":     resourceschema.StringAttribute{Computed: true},
+			"last_edited_at": resourceschema.StringAttribute{Computed: true},
+			"trigger":        resourceschema.SingleNestedAttribute{Required: true, Attributes: triggerAttrs},
+		},
+	}
+}
+
+// triggerV0ToBlock converts a version 0 flat trigger to the nested trigger,
+// keeping only the attributes its type uses.
+func triggerV0ToBlock(flat types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if flat.IsNull() {
+		return types.ObjectNull(triggerBlockAttrTypes), diags
+	}
+
+	attrs := flat.Attributes()
+	str := func(name string) types.String {
+		value, ok := attrs[name].(types.String)
+		if !ok {
+			return types.StringNull()
+		}
+		return value
+	}
+
+	block := map[string]attr.Value{
+		"event":     types.ObjectNull(eventTriggerAttrTypes),
+		"recurring": types.ObjectNull(recurringTriggerAttrTypes),
+		"one_time":  types.ObjectNull(oneTimeTriggerAttrTypes),
+	}
+	var d diag.Diagnostics
+	switch triggerType := str("type").ValueString(); triggerType {
+	case "event":
+		block["event"], d = types.ObjectValue(eventTriggerAttrTypes, map[string]attr.Value{
+			"event_type": str("event_type"),
+		})
+	case "recurring":
+		block["recurring"], d = types.ObjectValue(recurringTriggerAttrTypes, map[string]attr.Value{
+			"interval":    str("interval"),
+			"start_after": str("start_after"),
+			"timezone":    types.StringNull(),
+			"next_run_at": types.StringNull(),
+		})
+	case "one_time":
+		block["one_time"], d = types.ObjectValue(oneTimeTriggerAttrTypes, map[string]attr.Value{
+			"timestamp": str("timestamp"),
+		})
+	default:
+		diags.AddError("Unknown trigger type", fmt.Sprintf("Cannot upgrade state with trigger type %q.", triggerType))
+		return types.ObjectNull(triggerBlockAttrTypes), diags
+	}
+	diags.Append(d...)
+
+	obj, d := types.ObjectValue(triggerBlockAttrTypes, block)
+	diags.Append(d...)
+	return obj, diags
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &ScriptProfilesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ScriptProfilesDataSource{}
+
+func NewScriptProfilesDataSource() datasource.DataSource {
+	return &ScriptProfilesDataSource{}
+}
+
+type ScriptProfilesDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ScriptProfilesDataSourceModel struct {
+	ScriptId          types.Int64  `tfsdk:"script_id"`
+	Archived          types.Bool   `tfsdk:"archived"`
+	TriggerType       types.String `tfsdk:"trigger_type"`
+	Tag               types.String `tfsdk:"tag"`
+	IncludeRunResults types.Bool   `tfsdk:"include_run_results"`
+	Ids               types.List   `tfsdk:"ids"`
+	Profiles          types.List   `tfsdk:"profiles"`
+}
+
+// scriptProfileFilter holds the conditions applied to script profiles after
+// they are fetched. Zero values match everything; archived is applied by the
+// API.
+type scriptProfileFilter struct {
+	scriptID    int64
+	triggerType string
+	tag         string
+}
+
+func (d *ScriptProfilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script_profiles"
+}
+
+func (d *ScriptProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Landscape script profiles, e.g. to find profiles managed outside this configuration. Filters are combined; with none set, every profile is returned, including archived ones.",
+		Attributes: map[string]schema.Attribute{
+			"script_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return profiles that run this script.",
+			},
+			"archived": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When `true`, only return archived profiles; when `false`, only active ones.",
+			},
+			"trigger_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return profiles with this trigger type: `event`, `recurring`, or `one_time`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("event", "recurring", "one_time"),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return profiles that target computers with this tag.",
+			},
+			"include_run_results": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Also read the activities of each profile's last run to set `last_run_succeeded` and `last_run_failed`. This makes one request per matching profile, so it is off by default and the counts are null.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the matching profiles, in the order Landscape returned them.",
+			},
+			"profiles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching profiles, with the same attributes as the `landscape_script_profile` data source.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The script profile ID.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The title of the script profile.",
+						},
+						"script_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the script the profile executes.",
+						},
+						"access_group": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The access group of the profile.",
+						},
+						"username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The Linux username under which the script runs.",
+						},
+						"time_limit": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Maximum execution time for the script in seconds.",
+						},
+						"all_computers": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the profile targets all computers in the account.",
+						},
+						"tags": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Tags used to target computers.",
+						},
+						"archived": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the profile has been archived.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the profile was created (RFC3339).",
+						},
+						"last_edited_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the profile was last modified (RFC3339).",
+						},
+						"trigger": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The trigger that controls when the profile executes.",
+							Attributes: map[string]schema.Attribute{
+								"type":        schema.StringAttribute{Computed: true},
+								"event_type":  schema.StringAttribute{Computed: true},
+								"interval":    schema.StringAttribute{Computed: true},
+								"start_after": schema.StringAttribute{Computed: true},
+								"timestamp":   schema.StringAttribute{Computed: true},
+							},
+						},
+						"last_run_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the profile last ran (RFC3339).",
+						},
+						"next_run_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When Landscape will next run the profile (RFC3339, UTC).",
+						},
+						"computers_targeted": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of computers the profile targets.",
+						},
+						"last_run_succeeded": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of computers on which the last run succeeded.",
+						},
+						"last_run_failed": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of computers on which the last run failed or was canceled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ScriptProfilesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ScriptProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ScriptProfilesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	archived := landscape.ListScriptProfilesParamsArchivedAll
+	if !config.Archived.IsNull() {
+		archived = landscape.ListScriptProfilesParamsArchivedActive
+		if config.Archived.ValueBool() {
+			archived = landscape.ListScriptProfilesParamsArchivedArchived
+		}
+	}
+	filter := scriptProfileFilter{
+		scriptID:    config.ScriptId.ValueInt64(),
+		triggerType: config.TriggerType.ValueString(),
+		tag:         config.Tag.ValueString(),
+	}
+
+	profiles, diags := fetchScriptProfiles(ctx, d.client, archived)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elemType := types.ObjectType{AttrTypes: scriptProfileSummaryAttrTypes}
+	ids := []attr.Value{}
+	elems := []attr.Value{}
+	for _, p := range profiles {
+		if !filter.matches(p) {
+			continue
+		}
+		stats := scriptProfileRunStatsFrom(p, nil, false)
+		if config.IncludeRunResults.ValueBool() {
+			var statsDiags diag.Diagnostics
+			stats, statsDiags = fetchScriptProfileRunStats(ctx, d.client, p)
+			resp.Diagnostics.Append(statsDiags...)
+		}
+		model, d := scriptProfileDetailToModel(ctx, p, stats)
+		resp.Diagnostics.Append(d...)
+		obj, d := types.ObjectValueFrom(ctx, scriptProfileSummaryAttrTypes, model)
+		resp.Diagnostics.Append(d...)
+		ids = append(ids, types.Int64Value(int64(p.Id)))
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idList, diags := types.ListValue(types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	profileList, diags := types.ListValue(elemType, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Ids = idList
+	config.Profiles = profileList
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// matches reports whether p satisfies every condition of the filter.
+func (f scriptProfileFilter) matches(p landscape.ScriptProfileDetail) bool {
+	if f.scriptID != 0 && int64(p.ScriptId) != f.scriptID {
+		return false
+	}
+	if f.triggerType != "" {
+		if triggerType, err := p.Trigger.Discriminator(); err != nil || triggerType != f.triggerType {
+			return false
+		}
+	}
+	if f.tag != "" && !slices.Contains(p.Tags, f.tag) {
+		return false
+	}
+	return true
+}
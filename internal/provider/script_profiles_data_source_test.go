@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+func TestScriptProfilesDataSourceMetadata(t *testing.T) {
+	dataSource := NewScriptProfilesDataSource()
+
+	var resp datasource.MetadataResponse
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_script_profiles" {
+		t.Fatalf("expected data source type name landscape_script_profiles, got %q", resp.TypeName)
+	}
+}
+
+func TestScriptProfileFilterMatches(t *testing.T) {
+	var trigger landscape.ScriptProfileTriggerResponse
+	if err := trigger.FromScriptProfileEventTrigger(landscape.ScriptProfileEventTrigger{
+		TriggerType: "event",
+		EventType:   "post_enrollment",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	p := landscape.ScriptProfileDetail{ScriptId: 12, Tags: []string{"web", "prod"}, Trigger: trigger}
+
+	cases := []struct {
+		name   string
+		filter scriptProfileFilter
+		want   bool
+	}{
+		{"empty", scriptProfileFilter{}, true},
+		{"script", scriptProfileFilter{scriptID: 12}, true},
+		{"other script", scriptProfileFilter{scriptID: 13}, false},
+		{"trigger type", scriptProfileFilter{triggerType: "event"}, true},
+		{"other trigger type", scriptProfileFilter{triggerType: "recurring"}, false},
+		{"tag", scriptProfileFilter{tag: "prod"}, true},
+		{"other tag", scriptProfileFilter{tag: "db"}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.filter.matches(p); got != tc.want {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestAccScriptProfilesDataSourceInvalidTriggerType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptProfilesDataSourceInvalidTriggerTypeConfig,
+				ExpectError: regexp.MustCompile(`(?i)trigger_type`),
+			},
+		},
+	})
+}
+
+const testAccScriptProfilesDataSourceInvalidTriggerTypeConfig = `
+provider "landscape" {}
+
+data "landscape_script_profiles" "test" {
+  trigger_type = "cron"
+}
+`
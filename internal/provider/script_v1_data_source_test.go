@@ -41,3 +41,25 @@ provider "landscape" {}
 
 data "landscape_script_v1" "test" {}
 `
+
+func TestAccScriptV1DataSourceIDConflictsWithTitle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptV1DataSourceIDAndTitleConfig,
+				ExpectError: regexp.MustCompile(`(?i)title`),
+			},
+		},
+	})
+}
+
+const testAccScriptV1DataSourceIDAndTitleConfig = `
+provider "landscape" {}
+
+data "landscape_script_v1" "test" {
+  id    = 1
+  title = "backup-db"
+}
+`
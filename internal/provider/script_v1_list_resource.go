@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ list.ListResource = &ScriptV1ListResource{}
+var _ list.ListResourceWithConfigure = &ScriptV1ListResource{}
+
+func NewScriptV1ListResource() list.ListResource {
+	return &ScriptV1ListResource{}
+}
+
+type ScriptV1ListResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ScriptV1ListResourceModel struct {
+	TitleRegex types.String `tfsdk:"title_regex"`
+}
+
+func (r *ScriptV1ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script_v1"
+}
+
+func (r *ScriptV1ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the legacy V1 scripts in the account.",
+		Attributes: map[string]listschema.Attribute{
+			"title_regex": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list scripts whose title matches this regular expression.",
+			},
+		},
+	}
+}
+
+func (r *ScriptV1ListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResource(req, resp)
+}
+
+func (r *ScriptV1ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ScriptV1ListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	title, titleDiags := compileTitleRegex(config.TitleRegex)
+	diags.Append(titleDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	scripts, diags := fetchScripts(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := scriptFilter{title: title, scriptType: "v1"}
+	var matches []legacyScriptSummary
+	for _, s := range scripts {
+		if filter.matches(s) {
+			matches = append(matches, s)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matches, func(s legacyScriptSummary, result *list.ListResult) {
+		result.DisplayName = s.Title
+		result.Diagnostics.Append(result.Identity.Set(ctx, idIdentityModel{Id: types.Int64Value(int64(s.Id))})...)
+		if !req.IncludeResource {
+			return
+		}
+
+		v1Script, code, diags := fetchV1Script(ctx, r.client, int64(s.Id))
+		result.Diagnostics.Append(diags...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		state, diags := v1ScriptToResourceState(ctx, v1Script, code)
+		result.Diagnostics.Append(diags...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+	})
+}
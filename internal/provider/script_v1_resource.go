@@ -7,7 +7,6 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,6 +19,7 @@ import (
 
 var _ resource.Resource = &ScriptV1Resource{}
 var _ resource.ResourceWithImportState = &ScriptV1Resource{}
+var _ resource.ResourceWithIdentity = &ScriptV1Resource{}
 
 func NewScriptV1Resource() resource.Resource {
 	return &ScriptV1Resource{}
@@ -102,6 +102,10 @@ func (r *ScriptV1Resource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+func (r *ScriptV1Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Script identifier.")
+}
+
 func (r *ScriptV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -203,6 +207,7 @@ func (r *ScriptV1Resource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})...)
 }
 
 func (r *ScriptV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -249,6 +254,7 @@ func (r *ScriptV1Resource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})...)
 }
 
 func (r *ScriptV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -317,6 +323,7 @@ func (r *ScriptV1Resource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: newState.Id})...)
 }
 
 func (r *ScriptV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -339,35 +346,7 @@ func (r *ScriptV1Resource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *ScriptV1Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if req.ID == "" {
-		resp.Diagnostics.AddError("Invalid import ID", "No import ID was provided.")
-		return
-	}
-
-	parsed, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Import ID '%s' is not a valid integer: %s", req.ID, err))
-		return
-	}
-
-	v1AttachmentAttrType := map[string]attr.Type{
-		"filename": types.StringType,
-	}
-
-	stateModel := ScriptV1ResourceModel{
-		Id:          types.Int64Value(parsed),
-		Title:       types.StringNull(),
-		AccessGroup: types.StringNull(),
-		Code:        types.StringNull(),
-		CreatedBy:   types.ObjectNull(createdByAttrTypes),
-		Status:      types.StringNull(),
-		Username:    types.StringNull(),
-		TimeLimit:   types.Int64Null(),
-		Attachments: types.ListNull(types.ObjectType{AttrTypes: v1AttachmentAttrType}),
-	}
-
-	diags := resp.State.Set(ctx, stateModel)
-	resp.Diagnostics.Append(diags...)
+	importStateNumericID(ctx, "script", req, resp)
 }
 
 func v1ScriptToResourceState(_ context.Context, v1 landscape.V1Script, rawCode string) (ScriptV1ResourceModel, diag.Diagnostics) {
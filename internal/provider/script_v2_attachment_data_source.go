@@ -5,7 +5,9 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -24,10 +26,12 @@ type ScriptV2AttachmentDataSource struct {
 }
 
 type scriptV2AttachmentDataSourceModel struct {
-	Id       types.Int64  `tfsdk:"id"`
-	ScriptId types.Int64  `tfsdk:"script_id"`
-	Filename types.String `tfsdk:"filename"`
-	Content  types.String `tfsdk:"content"`
+	Id            types.Int64  `tfsdk:"id"`
+	ScriptId      types.Int64  `tfsdk:"script_id"`
+	Filename      types.String `tfsdk:"filename"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Sha256        types.String `tfsdk:"sha256"`
 }
 
 func (d *ScriptV2AttachmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -52,7 +56,15 @@ func (d *ScriptV2AttachmentDataSource) Schema(ctx context.Context, req datasourc
 			},
 			"content": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Attachment content.",
+				MarkdownDescription: "Attachment content. Null when the attachment is not valid UTF-8 text; use `content_base64` for binary files.",
+			},
+			"content_base64": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Attachment content encoded as base64.",
+			},
+			"sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hex-encoded SHA-256 of the attachment content.",
 			},
 		},
 	}
@@ -130,14 +142,20 @@ func (d *ScriptV2AttachmentDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	// NOTE: attachments are returned as raw plain text instead of JSON
-	bodyStr := string(attachmentContent.Body)
-	if attachmentContent.StatusCode() == 200 && bodyStr != "" {
+	// NOTE: attachments are returned as the raw file body instead of JSON
+	body := attachmentContent.Body
+	if attachmentContent.StatusCode() == 200 && len(body) > 0 {
+		content := types.StringNull()
+		if utf8.Valid(body) {
+			content = types.StringValue(string(body))
+		}
 		state := scriptV2AttachmentDataSourceModel{
-			Id:       config.Id,
-			ScriptId: config.ScriptId,
-			Filename: types.StringValue(filename),
-			Content:  types.StringValue(bodyStr),
+			Id:            config.Id,
+			ScriptId:      config.ScriptId,
+			Filename:      types.StringValue(filename),
+			Content:       content,
+			ContentBase64: types.StringValue(base64.StdEncoding.EncodeToString(body)),
+			Sha256:        types.StringValue(sha256Hex(body)),
 		}
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 		return
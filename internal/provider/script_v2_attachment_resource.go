@@ -7,19 +7,29 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"io"
-	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	landscape "github.com/jansdhillon/landscape-go-api-client/client"
 )
 
 var _ resource.Resource = &ScriptV2AttachmentResource{}
 var _ resource.ResourceWithImportState = &ScriptV2AttachmentResource{}
+var _ resource.ResourceWithModifyPlan = &ScriptV2AttachmentResource{}
+var _ resource.ResourceWithIdentity = &ScriptV2AttachmentResource{}
 
 func NewScriptV2AttachmentResource() resource.Resource {
 	return &ScriptV2AttachmentResource{}
@@ -30,10 +40,21 @@ type ScriptV2AttachmentResource struct {
 }
 
 type scriptV2AttachmentResourceModel struct {
-	Id       types.Int64  `tfsdk:"id"`
-	ScriptId types.Int64  `tfsdk:"script_id"`
-	Filename types.String `tfsdk:"filename"`
-	Content  types.String `tfsdk:"content"`
+	Id            types.Int64  `tfsdk:"id"`
+	ScriptId      types.Int64  `tfsdk:"script_id"`
+	Filename      types.String `tfsdk:"filename"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Source        types.String `tfsdk:"source"`
+	Sha256        types.String `tfsdk:"sha256"`
+	ReplacedId    types.Int64  `tfsdk:"replaced_id"`
+}
+
+// scriptV2AttachmentIdentityModel identifies an attachment by its script and
+// attachment IDs.
+type scriptV2AttachmentIdentityModel struct {
+	ScriptId types.Int64 `tfsdk:"script_id"`
+	Id       types.Int64 `tfsdk:"id"`
 }
 
 func (r *ScriptV2AttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,7 +63,11 @@ func (r *ScriptV2AttachmentResource) Metadata(ctx context.Context, req resource.
 
 func (r *ScriptV2AttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resourceschema.Schema{
-		MarkdownDescription: "V2 script attachment resource.",
+		MarkdownDescription: "V2 script attachment resource. Attachments cannot be edited in place, so any change replaces the attachment. " +
+			"Landscape allows one attachment per filename, so with `lifecycle { create_before_destroy = true }` the replacement " +
+			"removes the old attachment immediately before uploading the new one; the script is only without the file for the " +
+			"duration of that upload. Creating an attachment whose filename is already taken otherwise fails; import it instead. " +
+			"Import with `<script_id>/<attachment_id>` or `<script_id>/<filename>`.",
 		Attributes: map[string]resourceschema.Attribute{
 			"id": resourceschema.Int64Attribute{
 				Computed:            true,
@@ -51,14 +76,70 @@ func (r *ScriptV2AttachmentResource) Schema(ctx context.Context, req resource.Sc
 			"script_id": resourceschema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "ID of the V2 script this attachment belongs to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"filename": resourceschema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "Filename for the attachment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"content": resourceschema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Attachment content.",
+				Optional:            true,
+				MarkdownDescription: "Attachment content as UTF-8 text. Exactly one of `content`, `content_base64` or `source` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("content"),
+						path.MatchRoot("content_base64"),
+						path.MatchRoot("source"),
+					),
+				},
+			},
+			"content_base64": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Attachment content encoded as base64, for binary files.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a local file to upload. Only the path and `sha256` are kept in state, so large files do not bloat it; drift is detected by comparing hashes.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sha256": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hex-encoded SHA-256 of the attachment content.",
+			},
+			"replaced_id": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the attachment this one replaced, if it was created by a replacement. Only that attachment is overwritten when the filename is already taken on create.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ScriptV2AttachmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"script_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "ID of the V2 script the attachment belongs to.",
+			},
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Attachment identifier.",
 			},
 		},
 	}
@@ -81,104 +162,153 @@ func (r *ScriptV2AttachmentResource) Configure(ctx context.Context, req resource
 	r.client = client
 }
 
-func (r *ScriptV2AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *ScriptV2AttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
 	var plan scriptV2AttachmentResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	fileParam := fmt.Sprintf("%s$$%s", plan.Filename.ValueString(), base64.StdEncoding.EncodeToString([]byte(plan.Content.ValueString())))
-
-	// The API returns a plain JSON string (the filename), not an object, so
-	// using WithResponse would fail to unmarshal. Use the raw HTTP call instead.
-	rawCreateResp, err := r.client.LegacyCreateScriptAttachment(ctx, &landscape.LegacyCreateScriptAttachmentParams{
-		ScriptId: int(plan.ScriptId.ValueInt64()),
-		File:     fileParam,
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to create attachment", err.Error())
+	// Terraform plans a replacement twice: against the prior state, then as a
+	// create without prior state but with the private data of the first pass.
+	// Carry the old attachment's ID across so Create may overwrite it.
+	if req.State.Raw.IsNull() {
+		raw, diags := req.Private.GetKey(ctx, replacedAttachmentKey)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("replaced_id"), replacedAttachmentID(raw))...)
+	} else {
+		var id types.Int64
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, replacedAttachmentKey, []byte(strconv.FormatInt(id.ValueInt64(), 10)))...)
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	defer rawCreateResp.Body.Close()
-	if rawCreateResp.StatusCode != http.StatusOK {
-		errBody, _ := io.ReadAll(rawCreateResp.Body)
-		resp.Diagnostics.AddError("Failed to create attachment", fmt.Sprintf("status %s: %s", rawCreateResp.Status, errBody))
+
+	// Hash the configured content up front so that a changed `source` file
+	// shows up in the plan even though the path itself did not change.
+	if !plan.hasKnownContent() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sha256"), types.StringUnknown())...)
 		return
 	}
 
-	scriptRes, err := r.client.GetScriptWithResponse(ctx, landscape.ScriptIdPathParam(plan.ScriptId.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read script after creating attachment", err.Error())
+	content, diags := plan.contentBytes()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if scriptRes.JSON200 == nil {
-		resp.Diagnostics.AddError("Failed to read script", fmt.Sprintf("Error getting script: %s", scriptRes.Status()))
+	planned := sha256Hex(content)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sha256"), types.StringValue(planned))...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state scriptV2AttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.Sha256.ValueString() != planned {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("sha256"))
 		return
 	}
 
-	v2Script, err := scriptRes.JSON200.AsV2Script()
-	if err != nil {
-		resp.Diagnostics.AddError("Script is not a V2 script", "This attachment resource is for V2 scripts only. Use landscape_script_v1_attachment for V1 scripts.")
+	// The uploaded bytes are unchanged, so switching between `content`,
+	// `content_base64` and `source` (or adopting an imported attachment, which
+	// has none of them in state) does not need a new attachment.
+	var requiresReplace path.Paths
+	for _, p := range resp.RequiresReplace {
+		if p.Equal(path.Root("content")) || p.Equal(path.Root("content_base64")) || p.Equal(path.Root("source")) {
+			continue
+		}
+		requiresReplace = append(requiresReplace, p)
+	}
+	resp.RequiresReplace = requiresReplace
+}
+
+func (r *ScriptV2AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scriptV2AttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var attachmentID int64
-	var found bool
+	content, diags := plan.contentBytes()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if v2Script.Attachments != nil {
-		for _, att := range *v2Script.Attachments {
-			if att.Filename == plan.Filename.ValueString() {
-				attachmentID = int64(att.Id)
-				found = true
-				break
-			}
+	// Under create_before_destroy the old attachment still holds the
+	// filename. Overwrite it; Delete then leaves the new one alone.
+	existing, diags := fetchV2Script(ctx, r.client, plan.ScriptId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	overwrite, diags := attachmentOverwrite(v2ScriptAttachmentIDs(existing), plan.ScriptId.ValueInt64(), plan.Filename.ValueString(), plan.ReplacedId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if overwrite {
+		resp.Diagnostics.Append(removeV2ScriptAttachment(ctx, r.client, plan.ScriptId.ValueInt64(), plan.Filename.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if !found {
-		resp.Diagnostics.AddError("Attachment not found after creation", "Could not find the created attachment in the script")
+	resp.Diagnostics.Append(createV2ScriptAttachment(ctx, r.client, plan.ScriptId.ValueInt64(), plan.Filename.ValueString(), content)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state, diags := r.readAttachment(ctx, plan.ScriptId.ValueInt64(), attachmentID, plan.Filename.ValueString())
+	v2Script, diags := fetchV2Script(ctx, r.client, plan.ScriptId.ValueInt64())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	attachmentID, found := v2ScriptAttachmentIDs(v2Script)[plan.Filename.ValueString()]
+	if !found {
+		resp.Diagnostics.AddError("Attachment not found after creation", "Could not find the created attachment in the script")
+		return
+	}
+
+	plan.Id = types.Int64Value(attachmentID)
+	plan.Sha256 = types.StringValue(sha256Hex(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
-func (r *ScriptV2AttachmentResource) readAttachment(ctx context.Context, scriptID int64, attachmentID int64, filename string) (*scriptV2AttachmentResourceModel, diag.Diagnostics) {
+// readAttachment refreshes state from the remote attachment. Content is
+// compared by hash: configured `content` or `content_base64` is only rewritten
+// when the remote body differs, and `source` state never holds the body.
+func (r *ScriptV2AttachmentResource) readAttachment(ctx context.Context, state scriptV2AttachmentResourceModel) (*scriptV2AttachmentResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	attachmentContent, err := r.client.GetScriptAttachmentWithResponse(ctx, int(scriptID), int(attachmentID))
-	if err != nil {
-		diags.AddError("Failed to read script attachment content", err.Error())
+	content, d := fetchV2ScriptAttachmentContent(ctx, r.client, state.ScriptId.ValueInt64(), state.Id.ValueInt64())
+	diags.Append(d...)
+	if diags.HasError() {
 		return nil, diags
 	}
 
-	if attachmentContent.JSON404 != nil {
-		diags.AddError("Attachment not found", *attachmentContent.JSON404.Message)
-		return nil, diags
-	}
-
-	bodyStr := string(attachmentContent.Body)
-	if attachmentContent.StatusCode() == 200 && bodyStr != "" {
-		state := scriptV2AttachmentResourceModel{
-			Id:       types.Int64Value(attachmentID),
-			ScriptId: types.Int64Value(scriptID),
-			Filename: types.StringValue(filename),
-			Content:  types.StringValue(bodyStr),
+	remoteHash := sha256Hex(content)
+	if state.Sha256.ValueString() != remoteHash {
+		switch {
+		case !state.ContentBase64.IsNull():
+			state.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content))
+		case !state.Content.IsNull() && utf8.Valid(content):
+			state.Content = types.StringValue(string(content))
 		}
-		return &state, diags
 	}
-
-	diags.AddError("Error reading attachment", fmt.Sprintf("%s\n%s", attachmentContent.Status(), string(attachmentContent.Body)))
-	return nil, diags
-
+	state.Sha256 = types.StringValue(remoteHash)
+	return &state, diags
 }
 
 func (r *ScriptV2AttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -188,20 +318,44 @@ func (r *ScriptV2AttachmentResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	newState, diags := r.readAttachment(ctx, state.ScriptId.ValueInt64(), state.Id.ValueInt64(), state.Filename.ValueString())
+	v2Script, found, diags := lookupV2Script(ctx, r.client, state.ScriptId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if !ownsAttachment(v2ScriptAttachmentIDs(v2Script), state.Filename.ValueString(), state.Id.ValueInt64()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState, diags := r.readAttachment(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newState.identity())...)
 }
 
 func (r *ScriptV2AttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported for script attachments",
-		"Script attachments are immutable. To change the filename or content, delete and recreate the attachment.",
-	)
+	// Every configurable attribute requires replacement, so an update only
+	// carries computed values forward.
+	var plan scriptV2AttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	// The key is only meant for a replacement's create plan.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, replacedAttachmentKey, nil)...)
 }
 
 func (r *ScriptV2AttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -211,14 +365,186 @@ func (r *ScriptV2AttachmentResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	if _, err := r.client.LegacyRemoveScriptAttachmentWithResponse(ctx, &landscape.LegacyRemoveScriptAttachmentParams{
-		ScriptId: int(state.ScriptId.ValueInt64()),
-		Filename: state.Filename.ValueString(),
-	}); err != nil {
-		resp.Diagnostics.AddError("Failed to remove attachment", err.Error())
+	v2Script, found, diags := lookupV2Script(ctx, r.client, state.ScriptId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		return
+	}
+
+	// A replacement created before this delete may already own the filename.
+	if !ownsAttachment(v2ScriptAttachmentIDs(v2Script), state.Filename.ValueString(), state.Id.ValueInt64()) {
+		return
 	}
+
+	resp.Diagnostics.Append(removeV2ScriptAttachment(ctx, r.client, state.ScriptId.ValueInt64(), state.Filename.ValueString())...)
 }
 
+// ImportState accepts `<script_id>/<attachment_id>` or `<script_id>/<filename>`,
+// or an identity with both IDs, and resolves the rest from the script. A
+// numeric second segment is looked up as an ID first and as a filename when
+// no attachment has that ID, so files named e.g. `2024` can be imported.
 func (r *ScriptV2AttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var scriptID int64
+	var ref attachmentRef
+
+	if req.ID != "" {
+		scriptPart, attachmentPart, ok := strings.Cut(req.ID, "/")
+		parsedScriptID, err := strconv.ParseInt(scriptPart, 10, 64)
+		if !ok || err != nil || attachmentPart == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("Expected <script_id>/<attachment_id> or <script_id>/<filename>, got: %s", req.ID),
+			)
+			return
+		}
+		scriptID = parsedScriptID
+		ref.filename = attachmentPart
+		if parsedAttachmentID, err := strconv.ParseInt(attachmentPart, 10, 64); err == nil {
+			ref.id = parsedAttachmentID
+		}
+	} else {
+		var identity scriptV2AttachmentIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		scriptID = identity.ScriptId.ValueInt64()
+		ref.id = identity.Id.ValueInt64()
+	}
+
+	v2Script, diags := fetchV2Script(ctx, r.client, scriptID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filename, attachmentID, found := ref.resolve(v2ScriptAttachmentIDs(v2Script))
+	if !found {
+		target := ref.filename
+		if target == "" {
+			target = strconv.FormatInt(ref.id, 10)
+		}
+		resp.Diagnostics.AddError(
+			"Attachment not found",
+			fmt.Sprintf("Attachment %s not found in script %d", target, scriptID),
+		)
+		return
+	}
+
+	state := scriptV2AttachmentResourceModel{
+		Id:            types.Int64Value(attachmentID),
+		ScriptId:      types.Int64Value(scriptID),
+		Filename:      types.StringValue(filename),
+		Content:       types.StringNull(),
+		ContentBase64: types.StringNull(),
+		Source:        types.StringNull(),
+		Sha256:        types.StringNull(),
+		ReplacedId:    types.Int64Null(),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+}
+
+// replacedAttachmentKey is the private state key holding the ID of the
+// attachment a planned replacement replaces.
+const replacedAttachmentKey = "replaced_attachment_id"
+
+// replacedAttachmentID parses the ID stored under replacedAttachmentKey by the
+// first pass of a replacement plan. It is null for a plain create.
+func replacedAttachmentID(raw []byte) types.Int64 {
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(id)
+}
+
+// attachmentOverwrite reports whether Create must remove an existing
+// attachment with the planned filename first. That is only allowed for the
+// attachment being replaced; any other owner of the filename is an error.
+func attachmentOverwrite(existing map[string]int64, scriptID int64, filename string, replacedID types.Int64) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id, taken := existing[filename]
+	if !taken {
+		return false, diags
+	}
+	if !replacedID.IsNull() && !replacedID.IsUnknown() && replacedID.ValueInt64() == id {
+		return true, diags
+	}
+	diags.AddAttributeError(
+		path.Root("filename"),
+		"Attachment already exists",
+		fmt.Sprintf("Script %d already has an attachment named %q (ID %d). Import it with `%d/%s` to manage it, or choose another filename.",
+			scriptID, filename, id, scriptID, filename),
+	)
+	return false, diags
+}
+
+// ownsAttachment reports whether the attachment with the given ID still holds
+// filename, i.e. it has not been removed or overwritten by a replacement.
+func ownsAttachment(existing map[string]int64, filename string, id int64) bool {
+	current, ok := existing[filename]
+	return ok && current == id
+}
+
+// attachmentRef is the attachment part of an import ID: an attachment ID, a
+// filename, or, for a numeric segment, both candidates.
+type attachmentRef struct {
+	id       int64
+	filename string
+}
+
+// resolve finds the referenced attachment among the script's attachments,
+// preferring an ID match over a filename match.
+func (ref attachmentRef) resolve(existing map[string]int64) (string, int64, bool) {
+	if ref.id != 0 {
+		for name, id := range existing {
+			if id == ref.id {
+				return name, id, true
+			}
+		}
+	}
+	if id, ok := existing[ref.filename]; ok && ref.filename != "" {
+		return ref.filename, id, true
+	}
+	return "", 0, false
+}
+
+func (m scriptV2AttachmentResourceModel) identity() scriptV2AttachmentIdentityModel {
+	return scriptV2AttachmentIdentityModel{
+		ScriptId: m.ScriptId,
+		Id:       m.Id,
+	}
+}
+
+// hasKnownContent reports whether the configured content source is known at
+// plan time.
+func (m scriptV2AttachmentResourceModel) hasKnownContent() bool {
+	return !m.Content.IsUnknown() && !m.ContentBase64.IsUnknown() && !m.Source.IsUnknown()
+}
+
+// contentBytes returns the attachment body from `content`, `content_base64`
+// or the `source` file.
+func (m scriptV2AttachmentResourceModel) contentBytes() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.Source.IsNull():
+		content, err := os.ReadFile(m.Source.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("source"), "Failed to read attachment source", err.Error())
+			return nil, diags
+		}
+		return content, diags
+	case !m.ContentBase64.IsNull():
+		content, err := base64.StdEncoding.DecodeString(m.ContentBase64.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("content_base64"), "Invalid base64 attachment content", err.Error())
+			return nil, diags
+		}
+		return content, diags
+	}
+	return []byte(m.Content.ValueString()), diags
 }
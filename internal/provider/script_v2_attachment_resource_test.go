@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestScriptV2AttachmentResourceMetadata(t *testing.T) {
+	res := NewScriptV2AttachmentResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_script_v2_attachment" {
+		t.Fatalf("expected resource type name landscape_script_v2_attachment, got %q", resp.TypeName)
+	}
+}
+
+func TestScriptV2AttachmentContentBytes(t *testing.T) {
+	binary := []byte{0x00, 0xff, 0xfe, 0x10}
+	source := filepath.Join(t.TempDir(), "blob.bin")
+	if err := os.WriteFile(source, binary, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]scriptV2AttachmentResourceModel{
+		"content_base64": {
+			Content:       types.StringNull(),
+			ContentBase64: types.StringValue("AP/+EA=="),
+			Source:        types.StringNull(),
+		},
+		"source": {
+			Content:       types.StringNull(),
+			ContentBase64: types.StringNull(),
+			Source:        types.StringValue(source),
+		},
+	}
+	for name, m := range cases {
+		content, diags := m.contentBytes()
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", name, diags)
+		}
+		if sha256Hex(content) != sha256Hex(binary) {
+			t.Fatalf("%s: expected content %x, got %x", name, binary, content)
+		}
+	}
+}
+
+func TestScriptV2AttachmentResourceImportStateInvalidID(t *testing.T) {
+	res := NewScriptV2AttachmentResource()
+
+	for _, id := range []string{"12", "abc/report.txt", "12/"} {
+		var resp pfresource.ImportStateResponse
+		res.(pfresource.ResourceWithImportState).ImportState(context.Background(), pfresource.ImportStateRequest{ID: id}, &resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("expected an error for import ID %q", id)
+		}
+	}
+}
+
+func TestAttachmentOverwrite(t *testing.T) {
+	existing := map[string]int64{"report.txt": 7}
+
+	cases := []struct {
+		name          string
+		filename      string
+		replacedID    types.Int64
+		wantOverwrite bool
+		wantErr       bool
+	}{
+		{"free filename", "other.txt", types.Int64Null(), false, false},
+		{"taken on plain create", "report.txt", types.Int64Null(), false, true},
+		{"replacing the owner", "report.txt", types.Int64Value(7), true, false},
+		{"replacing another attachment", "report.txt", types.Int64Value(8), false, true},
+	}
+	for _, tc := range cases {
+		overwrite, diags := attachmentOverwrite(existing, 1, tc.filename, tc.replacedID)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error=%v, got %v", tc.name, tc.wantErr, diags)
+		}
+		if overwrite != tc.wantOverwrite {
+			t.Errorf("%s: expected overwrite=%v, got %v", tc.name, tc.wantOverwrite, overwrite)
+		}
+	}
+}
+
+func TestReplacedAttachmentID(t *testing.T) {
+	if got := replacedAttachmentID(nil); !got.IsNull() {
+		t.Errorf("expected null without a stored ID, got %v", got)
+	}
+	if got := replacedAttachmentID([]byte("7")); got.ValueInt64() != 7 {
+		t.Errorf("expected 7, got %v", got)
+	}
+}
+
+func TestOwnsAttachment(t *testing.T) {
+	existing := map[string]int64{"report.txt": 8}
+
+	if !ownsAttachment(existing, "report.txt", 8) {
+		t.Error("expected the attachment holding the filename to be owned")
+	}
+	// After a create_before_destroy replacement the filename belongs to the
+	// new attachment, so deleting the old one must not remove it.
+	if ownsAttachment(existing, "report.txt", 7) {
+		t.Error("expected an overwritten attachment not to be owned")
+	}
+	if ownsAttachment(existing, "gone.txt", 8) {
+		t.Error("expected a removed attachment not to be owned")
+	}
+}
+
+func TestAttachmentRefResolve(t *testing.T) {
+	existing := map[string]int64{"report.txt": 7, "2024": 8}
+
+	cases := []struct {
+		name         string
+		ref          attachmentRef
+		wantFilename string
+		wantID       int64
+		wantFound    bool
+	}{
+		{"by ID", attachmentRef{id: 7, filename: "7"}, "report.txt", 7, true},
+		{"by filename", attachmentRef{filename: "report.txt"}, "report.txt", 7, true},
+		{"numeric filename", attachmentRef{id: 2024, filename: "2024"}, "2024", 8, true},
+		{"identity", attachmentRef{id: 8}, "2024", 8, true},
+		{"missing", attachmentRef{id: 9, filename: "9"}, "", 0, false},
+	}
+	for _, tc := range cases {
+		filename, id, found := tc.ref.resolve(existing)
+		if filename != tc.wantFilename || id != tc.wantID || found != tc.wantFound {
+			t.Errorf("%s: expected (%q, %d, %v), got (%q, %d, %v)", tc.name, tc.wantFilename, tc.wantID, tc.wantFound, filename, id, found)
+		}
+	}
+}
+
+func TestAccScriptV2AttachmentResourceContentAndBase64(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptV2AttachmentResourceContentAndBase64Config,
+				ExpectError: regexp.MustCompile(`(?i)exactly one`),
+			},
+		},
+	})
+}
+
+const testAccScriptV2AttachmentResourceContentAndBase64Config = `
+provider "landscape" {}
+
+resource "landscape_script_v2_attachment" "test" {
+  script_id      = 1
+  filename       = "blob.bin"
+  content        = "inline"
+  content_base64 = "AP/+EA=="
+}
+`
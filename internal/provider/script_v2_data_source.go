@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	landscape "github.com/jansdhillon/landscape-go-api-client/client"
@@ -57,12 +59,17 @@ func (d *ScriptV2DataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		MarkdownDescription: "V2 script data source",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				Required:            true,
-				MarkdownDescription: "Script identifier for this account in Landscape.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Script identifier for this account in Landscape. Exactly one of `id` or `title` must be set.",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("title")),
+				},
 			},
 			"title": schema.StringAttribute{
-				MarkdownDescription: "The title of the script.",
+				Optional:            true,
 				Computed:            true,
+				MarkdownDescription: "The title of the script. When set instead of `id`, the active script with exactly this title is looked up; it is an error if no script or more than one script has the title.",
 			},
 			"access_group": schema.StringAttribute{
 				MarkdownDescription: "The access group the script is in. Defaults to 'global'.",
@@ -167,14 +174,27 @@ func (d *ScriptV2DataSource) Configure(ctx context.Context, req datasource.Confi
 
 func (d *ScriptV2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var idValue types.Int64
+	var titleValue types.String
 
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &idValue)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("title"), &titleValue)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if idValue.IsNull() && !titleValue.IsNull() {
+		id, diags := findScriptIDByTitle(ctx, d.client, titleValue.ValueString(), func(s legacyScriptSummary) bool {
+			return s.Status == scriptStatusActive
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		idValue = types.Int64Value(id)
+	}
+
 	if idValue.IsUnknown() || idValue.IsNull() {
-		resp.Diagnostics.AddError("Missing script ID", "The `id` attribute must be provided for the landscape_script_v2 data source.")
+		resp.Diagnostics.AddError("Missing script ID", "One of the `id` or `title` attributes must be provided for the landscape_script_v2 data source.")
 		return
 	}
 
@@ -41,3 +41,25 @@ provider "landscape" {}
 
 data "landscape_script_v2" "test" {}
 `
+
+func TestAccScriptV2DataSourceIDConflictsWithTitle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptV2DataSourceIDAndTitleConfig,
+				ExpectError: regexp.MustCompile(`(?i)title`),
+			},
+		},
+	})
+}
+
+const testAccScriptV2DataSourceIDAndTitleConfig = `
+provider "landscape" {}
+
+data "landscape_script_v2" "test" {
+  id    = 1
+  title = "backup-db"
+}
+`
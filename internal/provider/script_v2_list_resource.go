@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ list.ListResource = &ScriptV2ListResource{}
+var _ list.ListResourceWithConfigure = &ScriptV2ListResource{}
+
+func NewScriptV2ListResource() list.ListResource {
+	return &ScriptV2ListResource{}
+}
+
+type ScriptV2ListResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ScriptV2ListResourceModel struct {
+	TitleRegex      types.String `tfsdk:"title_regex"`
+	IncludeArchived types.Bool   `tfsdk:"include_archived"`
+}
+
+func (r *ScriptV2ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script_v2"
+}
+
+func (r *ScriptV2ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the V2 scripts in the account. Archived and redacted scripts are skipped unless `include_archived` is set.",
+		Attributes: map[string]listschema.Attribute{
+			"title_regex": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list scripts whose title matches this regular expression.",
+			},
+			"include_archived": listschema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Also list archived and redacted scripts.",
+			},
+		},
+	}
+}
+
+func (r *ScriptV2ListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResource(req, resp)
+}
+
+func (r *ScriptV2ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ScriptV2ListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	title, titleDiags := compileTitleRegex(config.TitleRegex)
+	diags.Append(titleDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	scripts, diags := fetchScripts(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := scriptFilter{title: title, scriptType: "v2"}
+	if !config.IncludeArchived.ValueBool() {
+		filter.status = scriptStatusActive
+	}
+	var matches []legacyScriptSummary
+	for _, s := range scripts {
+		if filter.matches(s) {
+			matches = append(matches, s)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matches, func(s legacyScriptSummary, result *list.ListResult) {
+		result.DisplayName = s.Title
+		result.Diagnostics.Append(result.Identity.Set(ctx, idIdentityModel{Id: types.Int64Value(int64(s.Id))})...)
+		if !req.IncludeResource {
+			return
+		}
+
+		v2Script, diags := fetchV2Script(ctx, r.client, int64(s.Id))
+		result.Diagnostics.Append(diags...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		state, diags := v2ScriptToResourceState(ctx, v2Script)
+		result.Diagnostics.Append(diags...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+	})
+}
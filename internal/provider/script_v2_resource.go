@@ -6,19 +6,25 @@ package provider
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	landscape "github.com/jansdhillon/landscape-go-api-client/client"
 )
 
 var _ resource.Resource = &ScriptV2Resource{}
 var _ resource.ResourceWithImportState = &ScriptV2Resource{}
+var _ resource.ResourceWithIdentity = &ScriptV2Resource{}
 
 func NewScriptV2Resource() resource.Resource {
 	return &ScriptV2Resource{}
@@ -45,9 +51,29 @@ type ScriptV2ResourceModel struct {
 	IsRedactable   types.Bool   `tfsdk:"is_redactable"`
 	LastEditedBy   types.Object `tfsdk:"last_edited_by"`
 	Attachments    types.List   `tfsdk:"attachments"`
+	Attachment     types.Set    `tfsdk:"attachment"`
 	ScriptProfiles types.List   `tfsdk:"script_profiles"`
 }
 
+// attachmentRecordsKey is the private state key holding an attachmentRecord
+// for each inline attachment, keyed by filename.
+const attachmentRecordsKey = "attachment_records"
+
+// attachmentRecord is the remote ID and SHA-256 of an inline attachment as
+// last uploaded or verified. Landscape cannot edit an attachment in place, so
+// a remote copy with the same ID still has this content.
+type attachmentRecord struct {
+	Id     int64  `json:"id"`
+	Sha256 string `json:"sha256"`
+}
+
+// scriptV2InlineAttachmentModel is one `attachment` block on the script.
+type scriptV2InlineAttachmentModel struct {
+	Filename types.String `tfsdk:"filename"`
+	Content  types.String `tfsdk:"content"`
+	Source   types.String `tfsdk:"source"`
+}
+
 func (r *ScriptV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_script_v2"
 }
@@ -148,9 +174,37 @@ func (r *ScriptV2Resource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "List of script profiles for this script.",
 			},
 		},
+		Blocks: map[string]resourceschema.Block{
+			"attachment": resourceschema.SetNestedBlock{
+				MarkdownDescription: "Attachments managed together with the script. Adding, changing or removing a block adds, replaces or removes the attachment on the next apply. Attachments not declared here, e.g. those managed by `landscape_script_v2_attachment`, are left untouched.",
+				NestedObject: resourceschema.NestedBlockObject{
+					Attributes: map[string]resourceschema.Attribute{
+						"filename": resourceschema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Filename for the attachment.",
+						},
+						"content": resourceschema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Attachment content. Conflicts with `source`.",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("source")),
+							},
+						},
+						"source": resourceschema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Path to a local file to upload as the attachment. Edits to the file are detected on refresh. Conflicts with `content`.",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
+func (r *ScriptV2Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Script identifier.")
+}
+
 func (r *ScriptV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -175,6 +229,7 @@ func (r *ScriptV2Resource) Create(ctx context.Context, req resource.CreateReques
 	var username types.String
 	var timeLimit types.Int64
 	var accessGroup types.String
+	var attachment types.Set
 
 	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("title"), &title)...)
 	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("code"), &codeAttr)...)
@@ -182,6 +237,7 @@ func (r *ScriptV2Resource) Create(ctx context.Context, req resource.CreateReques
 	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("time_limit"), &timeLimit)...)
 	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("access_group"), &accessGroup)...)
 	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attachment"), &attachment)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -229,6 +285,10 @@ func (r *ScriptV2Resource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// The script exists at this point, so an attachment failure is reported
+	// after saving state; Terraform then taints the script instead of losing it.
+	attachmentDiags := r.reconcileAttachments(ctx, int64(v2Script.Id), attachment, types.SetNull(types.ObjectType{AttrTypes: scriptInlineAttachmentAttrType}), nil)
+
 	getRes, err := r.client.GetScriptWithResponse(ctx, v2Script.Id)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read script after create", err.Error())
@@ -250,8 +310,14 @@ func (r *ScriptV2Resource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Attachment = attachment
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})...)
+	if !attachmentDiags.HasError() {
+		resp.Diagnostics.Append(setAttachmentRecords(ctx, resp.Private, inlineAttachmentRecords(ctx, attachment, script))...)
+	}
+	resp.Diagnostics.Append(attachmentDiags...)
 }
 
 func (r *ScriptV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -285,7 +351,18 @@ func (r *ScriptV2Resource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	raw, diags := req.Private.GetKey(ctx, attachmentRecordsKey)
+	resp.Diagnostics.Append(diags...)
+	var records map[string]attachmentRecord
+	state.Attachment, records, diags = r.refreshAttachments(ctx, v2Script, current.Attachment, parseAttachmentRecords(raw))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setAttachmentRecords(ctx, resp.Private, records)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})...)
 }
 
 func (r *ScriptV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -336,6 +413,13 @@ func (r *ScriptV2Resource) Update(ctx context.Context, req resource.UpdateReques
 
 	}
 
+	raw, diags := req.Private.GetKey(ctx, attachmentRecordsKey)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.reconcileAttachments(ctx, state.Id.ValueInt64(), plan.Attachment, state.Attachment, parseAttachmentRecords(raw))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	getRes, err := r.client.GetScriptWithResponse(ctx, int(state.Id.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read script after create", err.Error())
@@ -352,13 +436,16 @@ func (r *ScriptV2Resource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	state, diags := v2ScriptToResourceState(ctx, script)
+	newState, diags := v2ScriptToResourceState(ctx, script)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newState.Attachment = plan.Attachment
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setAttachmentRecords(ctx, resp.Private, inlineAttachmentRecords(ctx, plan.Attachment, script))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: newState.Id})...)
 }
 
 // Delete archives a V2 script (they can't be deleted).
@@ -376,7 +463,7 @@ func (r *ScriptV2Resource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *ScriptV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateNumericID(ctx, "script", req, resp)
 }
 
 func v2ScriptToResourceState(_ context.Context, v2Script landscape.V2Script) (ScriptV2ResourceModel, diag.Diagnostics) {
@@ -488,6 +575,216 @@ func v2ScriptToResourceState(_ context.Context, v2Script landscape.V2Script) (Sc
 		IsRedactable:   types.BoolPointerValue(v2Script.IsRedactable),
 		LastEditedBy:   lastEditedBy,
 		Attachments:    attachments,
+		Attachment:     types.SetValueMust(types.ObjectType{AttrTypes: scriptInlineAttachmentAttrType}, []attr.Value{}),
 		ScriptProfiles: scriptProfiles,
 	}, diags
 }
+
+// reconcileAttachments makes the script's attachments match the inline
+// `attachment` blocks. Only filenames present in the plan or the prior state
+// are touched, so attachments managed elsewhere survive. An existing
+// attachment is compared against its record, and only downloaded when it has
+// none.
+func (r *ScriptV2Resource) reconcileAttachments(ctx context.Context, scriptID int64, planned types.Set, prior types.Set, records map[string]attachmentRecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	desired, d := inlineAttachmentContents(ctx, planned)
+	diags.Append(d...)
+	var previous []scriptV2InlineAttachmentModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &previous, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	v2Script, d := fetchV2Script(ctx, r.client, scriptID)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	existing := v2ScriptAttachmentIDs(v2Script)
+
+	for _, p := range previous {
+		filename := p.Filename.ValueString()
+		if _, keep := desired[filename]; keep {
+			continue
+		}
+		if _, ok := existing[filename]; !ok {
+			continue
+		}
+		diags.Append(removeV2ScriptAttachment(ctx, r.client, scriptID, filename)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	filenames := make([]string, 0, len(desired))
+	for filename := range desired {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		content := desired[filename]
+		if attachmentID, ok := existing[filename]; ok {
+			remoteHash, d := r.attachmentHash(ctx, scriptID, attachmentID, records[filename])
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+			if remoteHash == sha256Hex(content) {
+				continue
+			}
+			diags.Append(removeV2ScriptAttachment(ctx, r.client, scriptID, filename)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		diags.Append(createV2ScriptAttachment(ctx, r.client, scriptID, filename, content)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// refreshAttachments drops inline attachments from state whose remote copy is
+// missing or no longer matches the configured content, so the next plan
+// uploads them again. Attachments are compared by ID and hash against their
+// records; only those without one are downloaded. It returns the records of
+// the attachments kept.
+func (r *ScriptV2Resource) refreshAttachments(ctx context.Context, v2Script landscape.V2Script, current types.Set, records map[string]attachmentRecord) (types.Set, map[string]attachmentRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: scriptInlineAttachmentAttrType}
+
+	var models []scriptV2InlineAttachmentModel
+	if !current.IsNull() && !current.IsUnknown() {
+		diags.Append(current.ElementsAs(ctx, &models, false)...)
+		if diags.HasError() {
+			return current, records, diags
+		}
+	}
+
+	existing := v2ScriptAttachmentIDs(v2Script)
+	kept := make([]scriptV2InlineAttachmentModel, 0, len(models))
+	keptRecords := map[string]attachmentRecord{}
+	for _, m := range models {
+		filename := m.Filename.ValueString()
+		attachmentID, ok := existing[filename]
+		if !ok {
+			continue
+		}
+		remoteHash, d := r.attachmentHash(ctx, int64(v2Script.Id), attachmentID, records[filename])
+		diags.Append(d...)
+		if diags.HasError() {
+			return current, records, diags
+		}
+		// A source file that can no longer be read is reported at apply time;
+		// keep the entry rather than guessing at drift.
+		local, d := m.contentBytes()
+		if !d.HasError() && sha256Hex(local) != remoteHash {
+			continue
+		}
+		kept = append(kept, m)
+		keptRecords[filename] = attachmentRecord{Id: attachmentID, Sha256: remoteHash}
+	}
+
+	set, d := types.SetValueFrom(ctx, elemType, kept)
+	diags.Append(d...)
+	return set, keptRecords, diags
+}
+
+// attachmentHash returns the SHA-256 of a remote attachment, taken from its
+// record when the record is for the same attachment ID and downloaded
+// otherwise.
+func (r *ScriptV2Resource) attachmentHash(ctx context.Context, scriptID, attachmentID int64, record attachmentRecord) (string, diag.Diagnostics) {
+	if record.Id == attachmentID && record.Sha256 != "" {
+		return record.Sha256, nil
+	}
+	content, diags := fetchV2ScriptAttachmentContent(ctx, r.client, scriptID, attachmentID)
+	return sha256Hex(content), diags
+}
+
+// inlineAttachmentRecords records the configured content of each inline
+// attachment against its ID on the script after an apply.
+func inlineAttachmentRecords(ctx context.Context, set types.Set, v2Script landscape.V2Script) map[string]attachmentRecord {
+	contents, diags := inlineAttachmentContents(ctx, set)
+	if diags.HasError() {
+		return nil
+	}
+	existing := v2ScriptAttachmentIDs(v2Script)
+	records := map[string]attachmentRecord{}
+	for filename, content := range contents {
+		if id, ok := existing[filename]; ok {
+			records[filename] = attachmentRecord{Id: id, Sha256: sha256Hex(content)}
+		}
+	}
+	return records
+}
+
+// parseAttachmentRecords decodes the attachment records from private state.
+// Missing or unreadable records only cost a download, so they decode to nil.
+func parseAttachmentRecords(raw []byte) map[string]attachmentRecord {
+	var records map[string]attachmentRecord
+	if len(raw) == 0 || json.Unmarshal(raw, &records) != nil {
+		return nil
+	}
+	return records
+}
+
+// privateStateSetter is the private state of a resource response.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setAttachmentRecords stores the attachment records in private state.
+func setAttachmentRecords(ctx context.Context, private privateStateSetter, records map[string]attachmentRecord) diag.Diagnostics {
+	raw, err := json.Marshal(records)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to encode attachment records", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, attachmentRecordsKey, raw)
+}
+
+// inlineAttachmentContents resolves each `attachment` block to its filename
+// and content.
+func inlineAttachmentContents(ctx context.Context, set types.Set) (map[string][]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	contents := map[string][]byte{}
+	if set.IsNull() || set.IsUnknown() {
+		return contents, diags
+	}
+
+	var models []scriptV2InlineAttachmentModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+	for _, m := range models {
+		filename := m.Filename.ValueString()
+		if _, dup := contents[filename]; dup {
+			diags.AddAttributeError(path.Root("attachment"), "Duplicate attachment filename",
+				fmt.Sprintf("The filename %q is used by more than one attachment block.", filename))
+			continue
+		}
+		content, d := m.contentBytes()
+		diags.Append(d...)
+		contents[filename] = content
+	}
+	return contents, diags
+}
+
+// contentBytes returns the attachment body from `content` or the `source` file.
+func (m scriptV2InlineAttachmentModel) contentBytes() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !m.Source.IsNull() && !m.Source.IsUnknown() {
+		content, err := os.ReadFile(m.Source.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("attachment"), "Failed to read attachment source", err.Error())
+			return nil, diags
+		}
+		return content, diags
+	}
+	return []byte(m.Content.ValueString()), diags
+}
@@ -5,11 +5,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
+	"sort"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
 )
 
 func TestScriptV2ResourceMetadata(t *testing.T) {
@@ -23,6 +31,98 @@ func TestScriptV2ResourceMetadata(t *testing.T) {
 	}
 }
 
+func TestRemoveV2ScriptAttachmentReportsStatus(t *testing.T) {
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("action"); got != "RemoveScriptAttachment" {
+			t.Errorf("unexpected action %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, `"run.sh"`)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := landscape.NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := removeV2ScriptAttachment(context.Background(), client, 1, "run.sh"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	status = http.StatusForbidden
+	if diags := removeV2ScriptAttachment(context.Background(), client, 1, "run.sh"); !diags.HasError() {
+		t.Error("expected a failed removal to be reported")
+	}
+}
+
+func TestScriptV2RefreshAttachmentsUsesRecords(t *testing.T) {
+	var downloads []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads = append(downloads, r.URL.Path)
+		fmt.Fprint(w, "remote")
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := landscape.NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &ScriptV2Resource{client: client}
+
+	elemType := types.ObjectType{AttrTypes: scriptInlineAttachmentAttrType}
+	attachment := func(filename, content string) attr.Value {
+		return types.ObjectValueMust(scriptInlineAttachmentAttrType, map[string]attr.Value{
+			"filename": types.StringValue(filename),
+			"content":  types.StringValue(content),
+			"source":   types.StringNull(),
+		})
+	}
+	current := types.SetValueMust(elemType, []attr.Value{
+		attachment("same.txt", "kept"),
+		attachment("replaced.txt", "old"),
+		attachment("unrecorded.txt", "remote"),
+		attachment("gone.txt", "gone"),
+	})
+	script := landscape.V2Script{Id: 1, Attachments: &[]landscape.ScriptAttachment{
+		{Id: 10, Filename: "same.txt"},
+		{Id: 21, Filename: "replaced.txt"},
+		{Id: 30, Filename: "unrecorded.txt"},
+	}}
+	records := map[string]attachmentRecord{
+		"same.txt":     {Id: 10, Sha256: sha256Hex([]byte("kept"))},
+		"replaced.txt": {Id: 20, Sha256: sha256Hex([]byte("old"))},
+	}
+
+	set, kept, diags := r.refreshAttachments(context.Background(), script, current, records)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var models []scriptV2InlineAttachmentModel
+	if diags := set.ElementsAs(context.Background(), &models, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+	var filenames []string
+	for _, m := range models {
+		filenames = append(filenames, m.Filename.ValueString())
+	}
+	sort.Strings(filenames)
+	if !reflect.DeepEqual(filenames, []string{"same.txt", "unrecorded.txt"}) {
+		t.Errorf("expected the unchanged attachments to be kept, got %v", filenames)
+	}
+	if want := map[string]attachmentRecord{
+		"same.txt":       {Id: 10, Sha256: sha256Hex([]byte("kept"))},
+		"unrecorded.txt": {Id: 30, Sha256: sha256Hex([]byte("remote"))},
+	}; !reflect.DeepEqual(kept, want) {
+		t.Errorf("expected records %v, got %v", want, kept)
+	}
+	if !reflect.DeepEqual(downloads, []string{"/api/scripts/1/attachments/21", "/api/scripts/1/attachments/30"}) {
+		t.Errorf("expected only attachments without a matching record to be downloaded, got %v", downloads)
+	}
+}
+
 func TestAccScriptV2ResourceMissingCode(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -36,6 +136,19 @@ func TestAccScriptV2ResourceMissingCode(t *testing.T) {
 	})
 }
 
+func TestAccScriptV2ResourceAttachmentContentAndSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptV2ResourceAttachmentContentAndSourceConfig,
+				ExpectError: regexp.MustCompile(`(?i)exactly one`),
+			},
+		},
+	})
+}
+
 const testAccScriptV2ResourceMissingCodeConfig = `
 provider "landscape" {}
 
@@ -43,3 +156,18 @@ resource "landscape_script_v2" "test" {
   title = "Test V2 Script"
 }
 `
+
+const testAccScriptV2ResourceAttachmentContentAndSourceConfig = `
+provider "landscape" {}
+
+resource "landscape_script_v2" "test" {
+  title = "Test V2 Script"
+  code  = "#!/bin/bash\necho test"
+
+  attachment {
+    filename = "config.txt"
+    content  = "inline"
+    source   = "config.txt"
+  }
+}
+`
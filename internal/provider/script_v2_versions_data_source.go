@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &ScriptV2VersionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ScriptV2VersionsDataSource{}
+
+func NewScriptV2VersionsDataSource() datasource.DataSource {
+	return &ScriptV2VersionsDataSource{}
+}
+
+type ScriptV2VersionsDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ScriptV2VersionsDataSourceModel struct {
+	ScriptId            types.Int64 `tfsdk:"script_id"`
+	LatestVersionNumber types.Int64 `tfsdk:"latest_version_number"`
+	Versions            types.List  `tfsdk:"versions"`
+}
+
+// scriptVersionAttrTypes is the Terraform attribute type map for one entry of
+// the versions list.
+var scriptVersionAttrTypes = map[string]attr.Type{
+	"version_number": types.Int64Type,
+	"edited_by":      types.ObjectType{AttrTypes: v2LastEditedByAttrTypes},
+	"edited_at":      types.StringType,
+	"code_sha256":    types.StringType,
+}
+
+// scriptVersion is a single entry returned by the script versions endpoint,
+// which the generated client does not cover.
+type scriptVersion struct {
+	VersionNumber int                     `json:"version_number"`
+	Interpreter   *string                 `json:"interpreter"`
+	Code          *string                 `json:"code"`
+	CreatedAt     *string                 `json:"created_at"`
+	CreatedBy     *landscape.ScriptEditor `json:"created_by"`
+}
+
+type scriptVersionListResponse struct {
+	Count   int             `json:"count"`
+	Results []scriptVersion `json:"results"`
+}
+
+func (d *ScriptV2VersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script_v2_versions"
+}
+
+func (d *ScriptV2VersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the version history of a V2 script. On Landscape servers without a version history endpoint, only the current version is listed.",
+		Attributes: map[string]schema.Attribute{
+			"script_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the V2 script.",
+			},
+			"latest_version_number": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The highest version number of the script.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Versions of the script, ordered from oldest to newest.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version_number": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The version number.",
+						},
+						"edited_by": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The Landscape user who saved this version.",
+							Attributes: map[string]schema.Attribute{
+								"id":   schema.Int64Attribute{Computed: true},
+								"name": schema.StringAttribute{Computed: true},
+							},
+						},
+						"edited_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When this version was saved.",
+						},
+						"code_sha256": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Hex-encoded SHA-256 of the version's code including the interpreter line, comparable with `sha256(landscape_script_v2.code)`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ScriptV2VersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ScriptV2VersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ScriptV2VersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, diags := fetchScriptVersions(ctx, d.client, int(config.ScriptId.ValueInt64()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, diags := scriptVersionsToList(versions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	latest := types.Int64Null()
+	if len(versions) > 0 {
+		latest = types.Int64Value(int64(versions[len(versions)-1].VersionNumber))
+	}
+
+	state := ScriptV2VersionsDataSourceModel{
+		ScriptId:            config.ScriptId,
+		LatestVersionNumber: latest,
+		Versions:            list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// fetchScriptVersions returns the versions of a V2 script sorted by version
+// number.
+func fetchScriptVersions(ctx context.Context, client *landscape.ClientWithResponses, scriptID int) ([]scriptVersion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawResp, err := doRESTRequest(ctx, client, http.MethodGet, fmt.Sprintf("/api/scripts/%d/versions", scriptID), nil, nil)
+	if err != nil {
+		diags.AddError("Failed to read script versions", err.Error())
+		return nil, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode == http.StatusNotFound {
+		// Servers without version history only expose the current version.
+		return currentScriptVersion(ctx, client, scriptID)
+	}
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read script versions", restStatusError(rawResp, body))
+		return nil, diags
+	}
+
+	var parsed scriptVersionListResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		diags.AddError("Failed to parse script versions response", err.Error())
+		return nil, diags
+	}
+
+	versions := parsed.Results
+	sort.Slice(versions, func(i, j int) bool { return versions[i].VersionNumber < versions[j].VersionNumber })
+	return versions, diags
+}
+
+// currentScriptVersion returns the script's current version as the only
+// entry of its history.
+func currentScriptVersion(ctx context.Context, client *landscape.ClientWithResponses, scriptID int) ([]scriptVersion, diag.Diagnostics) {
+	script, diags := fetchV2Script(ctx, client, int64(scriptID))
+	if diags.HasError() {
+		return nil, diags
+	}
+	if script.VersionNumber == nil {
+		return []scriptVersion{}, diags
+	}
+	return []scriptVersion{{
+		VersionNumber: *script.VersionNumber,
+		Interpreter:   script.Interpreter,
+		Code:          script.Code,
+		CreatedAt:     script.LastEditedAt,
+		CreatedBy:     script.LastEditedBy,
+	}}, diags
+}
+
+func scriptVersionsToList(versions []scriptVersion) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: scriptVersionAttrTypes}
+
+	elems := make([]attr.Value, 0, len(versions))
+	for _, v := range versions {
+		editedBy := types.ObjectNull(v2LastEditedByAttrTypes)
+		if v.CreatedBy != nil {
+			editorID := types.Int64Null()
+			if v.CreatedBy.Id != nil {
+				editorID = types.Int64Value(int64(*v.CreatedBy.Id))
+			}
+			obj, d := types.ObjectValue(v2LastEditedByAttrTypes, map[string]attr.Value{
+				"id":   editorID,
+				"name": types.StringPointerValue(v.CreatedBy.Name),
+			})
+			diags.Append(d...)
+			editedBy = obj
+		}
+
+		codeHash := types.StringNull()
+		if v.Interpreter != nil && v.Code != nil {
+			codeHash = types.StringValue(sha256Hex([]byte(fmt.Sprintf("#!%s\n%s", *v.Interpreter, *v.Code))))
+		}
+
+		elem, d := types.ObjectValue(scriptVersionAttrTypes, map[string]attr.Value{
+			"version_number": types.Int64Value(int64(v.VersionNumber)),
+			"edited_by":      editedBy,
+			"edited_at":      types.StringPointerValue(v.CreatedAt),
+			"code_sha256":    codeHash,
+		})
+		diags.Append(d...)
+		elems = append(elems, elem)
+	}
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+
+	list, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+	return list, diags
+}
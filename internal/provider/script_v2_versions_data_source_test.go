@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+func TestScriptV2VersionsDataSourceMetadata(t *testing.T) {
+	dataSource := NewScriptV2VersionsDataSource()
+
+	var resp datasource.MetadataResponse
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_script_v2_versions" {
+		t.Fatalf("expected data source type name landscape_script_v2_versions, got %q", resp.TypeName)
+	}
+}
+
+func TestScriptVersionsToList(t *testing.T) {
+	interpreter, code := "/bin/bash", "echo hello"
+	editorID, editorName := 3, "Jane"
+	editedAt := "2026-04-01T09:00:00Z"
+
+	list, diags := scriptVersionsToList([]scriptVersion{
+		{VersionNumber: 1},
+		{
+			VersionNumber: 2,
+			Interpreter:   &interpreter,
+			Code:          &code,
+			CreatedAt:     &editedAt,
+			CreatedBy:     &landscape.ScriptEditor{Id: &editorID, Name: &editorName},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	elems := list.Elements()
+	if len(elems) != 2 {
+		t.Fatalf("expected 2 versions, got %d", len(elems))
+	}
+
+	first := elems[0].(types.Object).Attributes()
+	if !first["code_sha256"].IsNull() || !first["edited_by"].IsNull() || !first["edited_at"].IsNull() {
+		t.Errorf("expected null fields for a version without details, got %v", first)
+	}
+
+	second := elems[1].(types.Object).Attributes()
+	if got := second["version_number"].(types.Int64).ValueInt64(); got != 2 {
+		t.Errorf("expected version 2, got %d", got)
+	}
+	if got, want := second["code_sha256"].(types.String).ValueString(), sha256Hex([]byte("#!/bin/bash\necho hello")); got != want {
+		t.Errorf("expected the hash of the code with its shebang line %q, got %q", want, got)
+	}
+	editedBy := second["edited_by"].(types.Object).Attributes()
+	if editedBy["id"].(types.Int64).ValueInt64() != 3 || editedBy["name"].(types.String).ValueString() != "Jane" {
+		t.Errorf("unexpected edited_by %v", editedBy)
+	}
+	if got := second["edited_at"].(types.String).ValueString(); got != editedAt {
+		t.Errorf("expected edited_at %q, got %q", editedAt, got)
+	}
+}
+
+func TestFetchScriptVersions(t *testing.T) {
+	withHistory := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/scripts/7/versions":
+			if !withHistory {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprint(w, `{"count": 2, "results": [{"version_number": 2}, {"version_number": 1}]}`)
+		case "/api/scripts/7":
+			fmt.Fprint(w, `{"id": 7, "title": "t", "status": "V2Script", "version_number": 4, "interpreter": "/bin/sh", "code": "true"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := landscape.NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	versions, diags := fetchScriptVersions(context.Background(), client, 7)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(versions) != 2 || versions[0].VersionNumber != 1 || versions[1].VersionNumber != 2 {
+		t.Errorf("expected versions 1 and 2 in order, got %+v", versions)
+	}
+
+	withHistory = false
+	versions, diags = fetchScriptVersions(context.Background(), client, 7)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(versions) != 1 || versions[0].VersionNumber != 4 || versions[0].Code == nil || *versions[0].Code != "true" {
+		t.Errorf("expected only the current version 4, got %+v", versions)
+	}
+}
+
+func TestAccScriptV2VersionsDataSourceRequiresScriptID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptV2VersionsDataSourceMissingScriptIDConfig,
+				ExpectError: regexp.MustCompile(`(?i)script_id`),
+			},
+		},
+	})
+}
+
+const testAccScriptV2VersionsDataSourceMissingScriptIDConfig = `
+provider "landscape" {}
+
+data "landscape_script_v2_versions" "test" {}
+`
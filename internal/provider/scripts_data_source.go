@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &ScriptsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ScriptsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ScriptsDataSource{}
+
+func NewScriptsDataSource() datasource.DataSource {
+	return &ScriptsDataSource{}
+}
+
+type ScriptsDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type ScriptsDataSourceModel struct {
+	TitleRegex  types.String `tfsdk:"title_regex"`
+	Status      types.String `tfsdk:"status"`
+	AccessGroup types.String `tfsdk:"access_group"`
+	ScriptType  types.String `tfsdk:"script_type"`
+	Creator     types.String `tfsdk:"creator"`
+	Ids         types.List   `tfsdk:"ids"`
+	Scripts     types.List   `tfsdk:"scripts"`
+}
+
+var scriptSummaryAttrTypes = map[string]attr.Type{
+	"id":           types.Int64Type,
+	"title":        types.StringType,
+	"script_type":  types.StringType,
+	"status":       types.StringType,
+	"access_group": types.StringType,
+	"creator":      types.StringType,
+	"username":     types.StringType,
+	"time_limit":   types.Int64Type,
+}
+
+// scriptFilter holds the conditions applied to scripts after they are
+// fetched. Zero values match everything.
+type scriptFilter struct {
+	title       *regexp.Regexp
+	status      string
+	accessGroup string
+	scriptType  string
+	creator     string
+}
+
+func (d *ScriptsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scripts"
+}
+
+func (d *ScriptsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists V1 and V2 Landscape scripts. Filters are combined; with none set, every script is returned, including archived and redacted ones.",
+		Attributes: map[string]schema.Attribute{
+			"title_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return scripts whose title matches this [RE2](https://github.com/google/re2/wiki/Syntax) regular expression, e.g. `^backup-`.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return scripts with this status: `ACTIVE`, `ARCHIVED` or `REDACTED` for V2 scripts, or `V1` for legacy scripts.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(scriptStatusActive, scriptStatusArchived, scriptStatusRedacted, scriptStatusV1),
+				},
+			},
+			"access_group": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return scripts in this access group.",
+			},
+			"script_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return scripts of this type, `v1` or `v2`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("v1", "v2"),
+				},
+			},
+			"creator": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return scripts created by the Landscape user with this name or email address.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the matching scripts, in the order Landscape returned them.",
+			},
+			"scripts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching scripts.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The script ID.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The script title.",
+						},
+						"script_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "`v1` or `v2`.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The script status (`V1`, `ACTIVE`, `ARCHIVED`, or `REDACTED`).",
+						},
+						"access_group": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The access group the script is in.",
+						},
+						"creator": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the Landscape user who created the script.",
+						},
+						"username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The Linux user the script runs as.",
+						},
+						"time_limit": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The time limit in seconds for the script to complete.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ScriptsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ScriptsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config ScriptsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TitleRegex.IsNull() || config.TitleRegex.IsUnknown() {
+		return
+	}
+	if _, err := regexp.Compile(config.TitleRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("title_regex"), "Invalid title_regex", err.Error())
+	}
+}
+
+func (d *ScriptsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ScriptsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := scriptFilter{
+		status:      config.Status.ValueString(),
+		accessGroup: config.AccessGroup.ValueString(),
+		scriptType:  config.ScriptType.ValueString(),
+		creator:     config.Creator.ValueString(),
+	}
+	if !config.TitleRegex.IsNull() {
+		re, err := regexp.Compile(config.TitleRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("title_regex"), "Invalid title_regex", err.Error())
+			return
+		}
+		filter.title = re
+	}
+
+	scripts, diags := fetchScripts(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elemType := types.ObjectType{AttrTypes: scriptSummaryAttrTypes}
+	ids := []attr.Value{}
+	elems := []attr.Value{}
+	for _, s := range scripts {
+		if !filter.matches(s) {
+			continue
+		}
+		obj, d := types.ObjectValue(scriptSummaryAttrTypes, scriptSummaryAttrValues(s))
+		resp.Diagnostics.Append(d...)
+		ids = append(ids, types.Int64Value(int64(s.Id)))
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idList, diags := types.ListValue(types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	scriptList, diags := types.ListValue(elemType, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Ids = idList
+	config.Scripts = scriptList
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// matches reports whether s satisfies every condition of the filter. The
+// creator condition matches either the creator's name or email address,
+// ignoring case.
+func (f scriptFilter) matches(s legacyScriptSummary) bool {
+	if f.title != nil && !f.title.MatchString(s.Title) {
+		return false
+	}
+	if f.status != "" && s.Status != f.status {
+		return false
+	}
+	if f.accessGroup != "" && (s.AccessGroup == nil || *s.AccessGroup != f.accessGroup) {
+		return false
+	}
+	if f.scriptType != "" && scriptTypeOf(s) != f.scriptType {
+		return false
+	}
+	if f.creator != "" && !strings.EqualFold(s.creatorName(), f.creator) && !strings.EqualFold(s.creatorEmail(), f.creator) {
+		return false
+	}
+	return true
+}
+
+// scriptTypeOf returns "v1" or "v2" for a script.
+func scriptTypeOf(s legacyScriptSummary) string {
+	if s.isV1() {
+		return "v1"
+	}
+	return "v2"
+}
+
+// scriptSummaryAttrValues converts a script into attribute values keyed like
+// scriptSummaryAttrTypes.
+func scriptSummaryAttrValues(s legacyScriptSummary) map[string]attr.Value {
+	timeLimit := types.Int64Null()
+	if s.TimeLimit != nil {
+		timeLimit = types.Int64Value(int64(*s.TimeLimit))
+	}
+	creator := types.StringNull()
+	if name := s.creatorName(); name != "" {
+		creator = types.StringValue(name)
+	}
+	return map[string]attr.Value{
+		"id":           types.Int64Value(int64(s.Id)),
+		"title":        types.StringValue(s.Title),
+		"script_type":  types.StringValue(scriptTypeOf(s)),
+		"status":       types.StringValue(s.Status),
+		"access_group": types.StringPointerValue(s.AccessGroup),
+		"creator":      creator,
+		"username":     types.StringPointerValue(s.Username),
+		"time_limit":   timeLimit,
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestScriptsDataSourceMetadata(t *testing.T) {
+	dataSource := NewScriptsDataSource()
+
+	var resp datasource.MetadataResponse
+	dataSource.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_scripts" {
+		t.Fatalf("expected data source type name landscape_scripts, got %q", resp.TypeName)
+	}
+}
+
+func TestScriptFilterMatches(t *testing.T) {
+	group := "server"
+	name := "Jane Doe"
+	email := "jane@example.com"
+	v1 := legacyScriptSummary{
+		Title:       "backup-db",
+		Status:      scriptStatusV1,
+		AccessGroup: &group,
+		Creator:     &legacyScriptAuthor{Name: &name, Email: &email},
+	}
+	v2 := legacyScriptSummary{Title: "restart-nginx", Status: scriptStatusArchived}
+
+	cases := []struct {
+		name   string
+		filter scriptFilter
+		script legacyScriptSummary
+		want   bool
+	}{
+		{"empty", scriptFilter{}, v2, true},
+		{"title", scriptFilter{title: regexp.MustCompile(`^backup-`)}, v1, true},
+		{"other title", scriptFilter{title: regexp.MustCompile(`^backup-`)}, v2, false},
+		{"status", scriptFilter{status: scriptStatusArchived}, v2, true},
+		{"other status", scriptFilter{status: scriptStatusActive}, v2, false},
+		{"access group", scriptFilter{accessGroup: "server"}, v1, true},
+		{"missing access group", scriptFilter{accessGroup: "server"}, v2, false},
+		{"v1 type", scriptFilter{scriptType: "v1"}, v1, true},
+		{"v2 type", scriptFilter{scriptType: "v2"}, v1, false},
+		{"creator name", scriptFilter{creator: "jane doe"}, v1, true},
+		{"creator email", scriptFilter{creator: "jane@example.com"}, v1, true},
+		{"unknown creator", scriptFilter{creator: "jane"}, v2, false},
+	}
+	for _, tc := range cases {
+		if got := tc.filter.matches(tc.script); got != tc.want {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestAccScriptsDataSourceInvalidTitleRegex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptsDataSourceInvalidTitleRegexConfig,
+				ExpectError: regexp.MustCompile(`(?i)title_regex`),
+			},
+		},
+	})
+}
+
+const testAccScriptsDataSourceInvalidTitleRegexConfig = `
+provider "landscape" {}
+
+data "landscape_scripts" "test" {
+  title_regex = "backup-("
+}
+`
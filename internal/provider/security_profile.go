@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+const securityProfileStatusArchived = "archived"
+
+var (
+	securityProfileBenchmarks = []string{
+		"cis_level1_workstation",
+		"cis_level1_server",
+		"cis_level2_workstation",
+		"cis_level2_server",
+		"disa_stig",
+	}
+	securityProfileModes = []string{"audit", "audit-fix", "audit-fix-restart"}
+)
+
+// securityProfile is a USG security profile as returned by the REST API.
+type securityProfile struct {
+	Id             int                       `json:"id"`
+	Name           string                    `json:"name"`
+	Title          string                    `json:"title"`
+	Benchmark      string                    `json:"benchmark"`
+	Mode           string                    `json:"mode"`
+	Schedule       string                    `json:"schedule"`
+	StartDate      *string                   `json:"start_date"`
+	AccessGroup    *string                   `json:"access_group"`
+	AllComputers   bool                      `json:"all_computers"`
+	Tags           []string                  `json:"tags"`
+	Status         string                    `json:"status"`
+	NextRunTime    *string                   `json:"next_run_time"`
+	LastRunResults *securityProfileRunResult `json:"last_run_results"`
+}
+
+// securityProfileRunResult summarises the latest audit of a profile.
+type securityProfileRunResult struct {
+	Passing    int     `json:"passing"`
+	Failing    int     `json:"failing"`
+	InProgress int     `json:"in_progress"`
+	NotStarted int     `json:"not_started"`
+	ReportURI  *string `json:"report_uri"`
+}
+
+type securityProfileListResponse struct {
+	Count   int               `json:"count"`
+	Results []securityProfile `json:"results"`
+}
+
+// securityProfileBody is the request body for creating and editing a
+// security profile.
+type securityProfileBody struct {
+	Title         string   `json:"title"`
+	Benchmark     string   `json:"benchmark,omitempty"`
+	Mode          string   `json:"mode"`
+	Schedule      string   `json:"schedule"`
+	StartDate     string   `json:"start_date"`
+	TailoringFile *string  `json:"tailoring_file,omitempty"`
+	AccessGroup   *string  `json:"access_group,omitempty"`
+	AllComputers  bool     `json:"all_computers"`
+	Tags          []string `json:"tags"`
+}
+
+// fetchSecurityProfile looks up a security profile by ID. The API has no
+// per-profile endpoint, so the list is paged through. found is false when no
+// profile has that ID.
+func fetchSecurityProfile(ctx context.Context, client *landscape.ClientWithResponses, id int64) (securityProfile, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	const limit = 100
+	for offset := 0; ; offset += limit {
+		query := url.Values{
+			"limit":  {strconv.Itoa(limit)},
+			"offset": {strconv.Itoa(offset)},
+		}
+		rawResp, err := doRESTRequest(ctx, client, http.MethodGet, "/api/security-profiles", query, nil)
+		if err != nil {
+			diags.AddError("Failed to read security profile", err.Error())
+			return securityProfile{}, false, diags
+		}
+		body, _ := io.ReadAll(rawResp.Body)
+		rawResp.Body.Close()
+		if rawResp.StatusCode != http.StatusOK {
+			diags.AddError("Failed to read security profile", restStatusError(rawResp, body))
+			return securityProfile{}, false, diags
+		}
+
+		var page securityProfileListResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			diags.AddError("Failed to parse security profile response", err.Error())
+			return securityProfile{}, false, diags
+		}
+		for _, p := range page.Results {
+			if int64(p.Id) == id {
+				return p, true, diags
+			}
+		}
+		if len(page.Results) < limit || offset+limit >= page.Count {
+			return securityProfile{}, false, diags
+		}
+	}
+}
+
+// sendSecurityProfile makes a REST call that returns a security profile.
+func sendSecurityProfile(ctx context.Context, client *landscape.ClientWithResponses, method, endpoint string, reqBody any, summary string) (securityProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var profile securityProfile
+
+	rawResp, err := doRESTRequest(ctx, client, method, endpoint, nil, reqBody)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return profile, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode < 200 || rawResp.StatusCode >= 300 {
+		diags.AddError(summary, restStatusError(rawResp, body))
+		return profile, diags
+	}
+
+	if err := json.Unmarshal(body, &profile); err != nil {
+		diags.AddError("Failed to parse security profile response", err.Error())
+	}
+	return profile, diags
+}
+
+func securityProfileEndpoint(id int64) string {
+	return fmt.Sprintf("/api/security-profiles/%d", id)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ datasource.DataSource = &SecurityProfileAuditResultsDataSource{}
+var _ datasource.DataSourceWithConfigure = &SecurityProfileAuditResultsDataSource{}
+
+func NewSecurityProfileAuditResultsDataSource() datasource.DataSource {
+	return &SecurityProfileAuditResultsDataSource{}
+}
+
+type SecurityProfileAuditResultsDataSource struct {
+	client *landscape.ClientWithResponses
+}
+
+type SecurityProfileAuditResultsDataSourceModel struct {
+	ProfileId   types.Int64  `tfsdk:"profile_id"`
+	Title       types.String `tfsdk:"title"`
+	Passing     types.Int64  `tfsdk:"passing"`
+	Failing     types.Int64  `tfsdk:"failing"`
+	InProgress  types.Int64  `tfsdk:"in_progress"`
+	NotStarted  types.Int64  `tfsdk:"not_started"`
+	ReportURI   types.String `tfsdk:"report_uri"`
+	NextRunTime types.String `tfsdk:"next_run_time"`
+}
+
+func (d *SecurityProfileAuditResultsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_profile_audit_results"
+}
+
+func (d *SecurityProfileAuditResultsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the summary of the latest audit run of a Landscape security profile. Counts are zero until the profile has run.",
+		Attributes: map[string]schema.Attribute{
+			"profile_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the security profile.",
+			},
+			"title": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Title of the security profile.",
+			},
+			"passing": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of computers that passed the last audit.",
+			},
+			"failing": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of computers that failed the last audit.",
+			},
+			"in_progress": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of computers still running the last audit.",
+			},
+			"not_started": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of targeted computers that have not started the last audit.",
+			},
+			"report_uri": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Where to download the detailed report of the last audit, if one is available.",
+			},
+			"next_run_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the profile next runs.",
+			},
+		},
+	}
+}
+
+func (d *SecurityProfileAuditResultsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *SecurityProfileAuditResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SecurityProfileAuditResultsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, found, diags := fetchSecurityProfile(ctx, d.client, config.ProfileId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Security profile not found", fmt.Sprintf("No security profile has ID %d", config.ProfileId.ValueInt64()))
+		return
+	}
+
+	results := securityProfileRunResult{}
+	if profile.LastRunResults != nil {
+		results = *profile.LastRunResults
+	}
+	state := SecurityProfileAuditResultsDataSourceModel{
+		ProfileId:   config.ProfileId,
+		Title:       types.StringValue(profile.Title),
+		Passing:     types.Int64Value(int64(results.Passing)),
+		Failing:     types.Int64Value(int64(results.Failing)),
+		InProgress:  types.Int64Value(int64(results.InProgress)),
+		NotStarted:  types.Int64Value(int64(results.NotStarted)),
+		ReportURI:   types.StringPointerValue(results.ReportURI),
+		NextRunTime: types.StringPointerValue(profile.NextRunTime),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &SecurityProfileResource{}
+var _ resource.ResourceWithImportState = &SecurityProfileResource{}
+var _ resource.ResourceWithIdentity = &SecurityProfileResource{}
+var _ resource.ResourceWithValidateConfig = &SecurityProfileResource{}
+
+func NewSecurityProfileResource() resource.Resource {
+	return &SecurityProfileResource{}
+}
+
+// SecurityProfileResource manages Ubuntu Security Guide profiles through the
+// REST API, which the generated client does not cover yet.
+type SecurityProfileResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type SecurityProfileResourceModel struct {
+	Id            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Title         types.String `tfsdk:"title"`
+	Benchmark     types.String `tfsdk:"benchmark"`
+	Mode          types.String `tfsdk:"mode"`
+	Schedule      types.String `tfsdk:"schedule"`
+	StartDate     types.String `tfsdk:"start_date"`
+	TailoringFile types.String `tfsdk:"tailoring_file"`
+	AccessGroup   types.String `tfsdk:"access_group"`
+	AllComputers  types.Bool   `tfsdk:"all_computers"`
+	Tags          types.Set    `tfsdk:"tags"`
+}
+
+func (r *SecurityProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_profile"
+}
+
+func (r *SecurityProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Manages a Landscape security profile, which audits (and optionally fixes) computers against an Ubuntu Security Guide benchmark on a schedule. Destroying the profile archives it.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the security profile.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"name": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The slug name of the profile returned by the API.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Title for the security profile.",
+			},
+			"benchmark": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "USG benchmark to apply: `cis_level1_workstation`, `cis_level1_server`, `cis_level2_workstation`, `cis_level2_server` or `disa_stig`. Changing this forces a new profile.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(securityProfileBenchmarks...),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"mode": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "What each run does: `audit` only reports, `audit-fix` also remediates, and `audit-fix-restart` remediates and restarts the computer.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(securityProfileModes...),
+				},
+			},
+			"schedule": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "iCalendar recurrence rule for the runs, e.g. `FREQ=MONTHLY;INTERVAL=1`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`(^|;)FREQ=(DAILY|WEEKLY|MONTHLY|YEARLY)(;|$)`),
+						"must be a recurrence rule with a DAILY, WEEKLY, MONTHLY or YEARLY FREQ"),
+				},
+			},
+			"start_date": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "RFC3339 datetime of the first run.",
+			},
+			"tailoring_file": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Content of a USG tailoring file that enables, disables or tunes rules of the benchmark. The API does not return it, so changes made outside Terraform are not detected. The API cannot clear it either, so removing it forces a new profile.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(tailoringFileRemoved,
+						"Removing the tailoring file forces a new profile.",
+						"Removing the tailoring file forces a new profile."),
+				},
+			},
+			"access_group": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Access group to create the profile in.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"all_computers": resourceschema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to apply the profile to all computers.",
+			},
+			"tags": resourceschema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Tags used to target computers.",
+			},
+		},
+	}
+}
+
+func (r *SecurityProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Security profile identifier.")
+}
+
+func (r *SecurityProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *SecurityProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var startDate types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_date"), &startDate)...)
+	if resp.Diagnostics.HasError() || startDate.IsNull() || startDate.IsUnknown() {
+		return
+	}
+
+	_, diags := parseScheduleTimestamp("start_date", startDate.ValueString())
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SecurityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SecurityProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := planToSecurityProfileBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body.Benchmark = plan.Benchmark.ValueString()
+	body.AccessGroup = plan.AccessGroup.ValueStringPointer()
+
+	profile, diags := sendSecurityProfile(ctx, r.client, http.MethodPost, "/api/security-profiles", body, "Failed to create security profile")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.Int64Value(int64(profile.Id))
+	plan.Name = types.StringValue(profile.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})...)
+}
+
+func (r *SecurityProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SecurityProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, found, diags := fetchSecurityProfile(ctx, r.client, state.Id.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found || profile.Status == securityProfileStatusArchived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, sortedStrings(profile.Tags))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Name = types.StringValue(profile.Name)
+	state.Title = types.StringValue(profile.Title)
+	state.Benchmark = types.StringValue(profile.Benchmark)
+	state.Mode = types.StringValue(profile.Mode)
+	state.Schedule = types.StringValue(profile.Schedule)
+	if profile.StartDate != nil {
+		state.StartDate = keepSameInstant(state.StartDate, "", types.StringValue(*profile.StartDate))
+	}
+	if profile.AccessGroup != nil && !state.AccessGroup.IsNull() {
+		state.AccessGroup = types.StringValue(*profile.AccessGroup)
+	}
+	state.AllComputers = types.BoolValue(profile.AllComputers)
+	state.Tags = tags
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})...)
+}
+
+func (r *SecurityProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SecurityProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := planToSecurityProfileBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags = sendSecurityProfile(ctx, r.client, http.MethodPatch, securityProfileEndpoint(state.Id.ValueInt64()), body, "Failed to update security profile")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.Name = state.Name
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})...)
+}
+
+func (r *SecurityProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SecurityProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Security profiles cannot be deleted, only archived.
+	rawResp, err := doRESTRequest(ctx, r.client, http.MethodPost, securityProfileEndpoint(state.Id.ValueInt64())+":archive", nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to archive security profile", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	if rawResp.StatusCode >= 300 && rawResp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(rawResp.Body)
+		resp.Diagnostics.AddError("Failed to archive security profile", restStatusError(rawResp, body))
+	}
+}
+
+func (r *SecurityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateNumericID(ctx, "security profile", req, resp)
+}
+
+// planToSecurityProfileBody builds the attributes that can be changed in
+// place. The benchmark and access group are only sent on create.
+func planToSecurityProfileBody(ctx context.Context, plan SecurityProfileResourceModel) (securityProfileBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags := []string{}
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
+		diags.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	return securityProfileBody{
+		Title:         plan.Title.ValueString(),
+		Mode:          plan.Mode.ValueString(),
+		Schedule:      plan.Schedule.ValueString(),
+		StartDate:     plan.StartDate.ValueString(),
+		TailoringFile: plan.TailoringFile.ValueStringPointer(),
+		AllComputers:  plan.AllComputers.ValueBool(),
+		Tags:          tags,
+	}, diags
+}
+
+// tailoringFileRemoved reports whether a planned tailoring file clears one
+// set in state, which the API can only do by creating a new profile.
+func tailoringFileRemoved(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSecurityProfileResourceMetadata(t *testing.T) {
+	res := NewSecurityProfileResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_security_profile" {
+		t.Fatalf("expected resource type name landscape_security_profile, got %q", resp.TypeName)
+	}
+}
+
+func TestTailoringFileRemoved(t *testing.T) {
+	cases := []struct {
+		name        string
+		state, plan types.String
+		want        bool
+	}{
+		{"removed", types.StringValue("rules"), types.StringNull(), true},
+		{"changed", types.StringValue("rules"), types.StringValue("other"), false},
+		{"added", types.StringNull(), types.StringValue("rules"), false},
+		{"never set", types.StringNull(), types.StringNull(), false},
+	}
+	for _, tc := range cases {
+		var resp stringplanmodifier.RequiresReplaceIfFuncResponse
+		tailoringFileRemoved(context.Background(), planmodifier.StringRequest{StateValue: tc.state, PlanValue: tc.plan}, &resp)
+		if resp.RequiresReplace != tc.want {
+			t.Errorf("%s: expected replace %v, got %v", tc.name, tc.want, resp.RequiresReplace)
+		}
+	}
+}
+
+func TestAccSecurityProfileResourceInvalidStartDate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSecurityProfileResourceInvalidStartDateConfig,
+				ExpectError: regexp.MustCompile(`(?i)start_date`),
+			},
+		},
+	})
+}
+
+const testAccSecurityProfileResourceInvalidStartDateConfig = `
+provider "landscape" {}
+
+resource "landscape_security_profile" "test" {
+  title      = "cis-servers"
+  benchmark  = "cis_level1_server"
+  mode       = "audit"
+  schedule   = "FREQ=MONTHLY;INTERVAL=1"
+  start_date = "next tuesday"
+}
+`
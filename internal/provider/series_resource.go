@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +23,7 @@ import (
 
 var _ resource.Resource = &SeriesResource{}
 var _ resource.ResourceWithImportState = &SeriesResource{}
+var _ resource.ResourceWithIdentity = &SeriesResource{}
 
 func NewSeriesResource() resource.Resource {
 	return &SeriesResource{}
@@ -44,6 +46,12 @@ type SeriesResourceModel struct {
 	IncludeUdeb   types.Bool   `tfsdk:"include_udeb"`
 }
 
+// seriesIdentityModel identifies a series by its distribution and name.
+type seriesIdentityModel struct {
+	Distribution types.String `tfsdk:"distribution"`
+	Name         types.String `tfsdk:"name"`
+}
+
 func (r *SeriesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_series"
 }
@@ -107,6 +115,21 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *SeriesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"distribution": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the parent distribution.",
+			},
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the series.",
+			},
+		},
+	}
+}
+
 func (r *SeriesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -185,6 +208,7 @@ func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -237,6 +261,7 @@ func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *SeriesResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
@@ -265,8 +290,19 @@ func (r *SeriesResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
-// ImportState accepts "<distribution>/<name>".
+// ImportState accepts "<distribution>/<name>" or an identity with both.
 func (r *SeriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		var identity seriesIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("distribution"), identity.Distribution)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), identity.Name)...)
+		return
+	}
+
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: <distribution>/<name>")
@@ -275,3 +311,10 @@ func (r *SeriesResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("distribution"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
 }
+
+func (m SeriesResourceModel) identity() seriesIdentityModel {
+	return seriesIdentityModel{
+		Distribution: m.Distribution,
+		Name:         m.Name,
+	}
+}
@@ -0,0 +1,474 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &UpgradeProfileResource{}
+var _ resource.ResourceWithImportState = &UpgradeProfileResource{}
+var _ resource.ResourceWithIdentity = &UpgradeProfileResource{}
+var _ resource.ResourceWithValidateConfig = &UpgradeProfileResource{}
+
+func NewUpgradeProfileResource() resource.Resource {
+	return &UpgradeProfileResource{}
+}
+
+type UpgradeProfileResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type UpgradeProfileResourceModel struct {
+	Id            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Title         types.String `tfsdk:"title"`
+	UpgradeType   types.String `tfsdk:"upgrade_type"`
+	Every         types.String `tfsdk:"every"`
+	OnDays        types.Set    `tfsdk:"on_days"`
+	AtHour        types.Int64  `tfsdk:"at_hour"`
+	AtMinute      types.Int64  `tfsdk:"at_minute"`
+	DeliverWithin types.Int64  `tfsdk:"deliver_within"`
+	Autoremove    types.Bool   `tfsdk:"autoremove"`
+	AccessGroup   types.String `tfsdk:"access_group"`
+	AllComputers  types.Bool   `tfsdk:"all_computers"`
+	Tags          types.Set    `tfsdk:"tags"`
+}
+
+// legacyUpgradeProfile is an upgrade profile as returned by the legacy
+// GetUpgradeProfiles action. Some Landscape versions return the schedule
+// numbers as strings, hence json.Number.
+type legacyUpgradeProfile struct {
+	Id            int         `json:"id"`
+	Name          string      `json:"name"`
+	Title         string      `json:"title"`
+	UpgradeType   string      `json:"upgrade_type"`
+	Every         string      `json:"every"`
+	OnDays        []string    `json:"on_days"`
+	AtHour        json.Number `json:"at_hour"`
+	AtMinute      json.Number `json:"at_minute"`
+	DeliverWithin json.Number `json:"deliver_within"`
+	Autoremove    bool        `json:"autoremove"`
+	AccessGroup   *string     `json:"access_group"`
+	AllComputers  bool        `json:"all_computers"`
+	Tags          []string    `json:"tags"`
+}
+
+func (r *UpgradeProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_upgrade_profile"
+}
+
+func (r *UpgradeProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Manages a Landscape upgrade profile, which applies package or security upgrades on a schedule.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the upgrade profile.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"name": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The slug name of the profile returned by the API.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Title for the upgrade profile.",
+			},
+			"upgrade_type": resourceschema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("all"),
+				MarkdownDescription: "Which upgrades to apply: `all` or `security`. Defaults to `all`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "security"),
+				},
+			},
+			"every": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How often the profile runs: `hour` or `week`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("hour", "week"),
+				},
+			},
+			"on_days": resourceschema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Days of the week to run on (`mo`, `tu`, `we`, `th`, `fr`, `sa`, `su`). Required when `every` is `week`.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(scheduleWeekdays...)),
+				},
+			},
+			"at_hour": resourceschema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Hour (0-23) to run at. Required when `every` is `week`; not allowed when `every` is `hour`.",
+				Validators: []validator.Int64{
+					int64validator.Between(scheduleHour.min, scheduleHour.max),
+				},
+			},
+			"at_minute": resourceschema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Minute (0-59) to run at.",
+				Validators: []validator.Int64{
+					int64validator.Between(scheduleMinute.min, scheduleMinute.max),
+				},
+			},
+			"deliver_within": resourceschema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Number of hours after the scheduled time within which the upgrade must be delivered. Defaults to `1`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"autoremove": resourceschema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to also autoremove packages that are no longer needed.",
+			},
+			"access_group": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Access group to create the profile in.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"all_computers": resourceschema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to apply the profile to all computers.",
+			},
+			"tags": resourceschema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Tags used to target computers.",
+			},
+		},
+	}
+}
+
+func (r *UpgradeProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Name of the upgrade profile.")
+}
+
+func (r *UpgradeProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *UpgradeProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UpgradeProfileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCalendarSchedule(config.Every, config.OnDays, config.AtHour)...)
+}
+
+func (r *UpgradeProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UpgradeProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var onDays []string
+	if !plan.OnDays.IsNull() {
+		resp.Diagnostics.Append(plan.OnDays.ElementsAs(ctx, &onDays, false)...)
+	}
+	var tags []string
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgradeType := plan.UpgradeType.ValueString()
+	deliverWithin := int(plan.DeliverWithin.ValueInt64())
+	autoremove := plan.Autoremove.ValueBool()
+	allComputers := plan.AllComputers.ValueBool()
+	createParams := &landscape.LegacyCreateUpgradeProfileParams{
+		Title:         plan.Title.ValueString(),
+		Every:         plan.Every.ValueString(),
+		AtMinute:      int(plan.AtMinute.ValueInt64()),
+		DeliverWithin: &deliverWithin,
+		UpgradeType:   &upgradeType,
+		Autoremove:    &autoremove,
+		AllComputers:  &allComputers,
+	}
+	if len(onDays) > 0 {
+		createParams.OnDays = &onDays
+	}
+	if !plan.AtHour.IsNull() {
+		h := int(plan.AtHour.ValueInt64())
+		createParams.AtHour = &h
+	}
+	if len(tags) > 0 {
+		createParams.Tags = &tags
+	}
+	if !plan.AccessGroup.IsNull() && !plan.AccessGroup.IsUnknown() {
+		v := plan.AccessGroup.ValueString()
+		createParams.AccessGroup = &v
+	}
+
+	rawResp, err := r.client.LegacyCreateUpgradeProfile(ctx, createParams)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create upgrade profile", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Failed to create upgrade profile", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return
+	}
+
+	profile, err := landscape.ParseLegacyResponse[legacyUpgradeProfile](body)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse upgrade profile response", err.Error())
+		return
+	}
+	if profile.Name == "" {
+		resp.Diagnostics.AddError("Failed to parse upgrade profile response", "response missing 'name' field")
+		return
+	}
+
+	plan.Id = types.Int64Value(int64(profile.Id))
+	plan.Name = types.StringValue(profile.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *UpgradeProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UpgradeProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, found, diags := fetchUpgradeProfile(ctx, r.client, state.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(upgradeProfileToState(ctx, profile, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
+}
+
+func (r *UpgradeProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UpgradeProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileName := state.Name.ValueString()
+	plan.Id = state.Id
+	plan.Name = state.Name
+
+	onDays := []string{}
+	if !plan.OnDays.IsNull() {
+		resp.Diagnostics.Append(plan.OnDays.ElementsAs(ctx, &onDays, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	title := plan.Title.ValueString()
+	every := plan.Every.ValueString()
+	atMinute := int(plan.AtMinute.ValueInt64())
+	deliverWithin := int(plan.DeliverWithin.ValueInt64())
+	upgradeType := plan.UpgradeType.ValueString()
+	autoremove := plan.Autoremove.ValueBool()
+	editParams := &landscape.LegacyEditUpgradeProfileParams{
+		Name:          profileName,
+		Title:         &title,
+		Every:         &every,
+		OnDays:        &onDays,
+		AtMinute:      &atMinute,
+		DeliverWithin: &deliverWithin,
+		UpgradeType:   &upgradeType,
+		Autoremove:    &autoremove,
+	}
+	if !plan.AtHour.IsNull() {
+		h := int(plan.AtHour.ValueInt64())
+		editParams.AtHour = &h
+	}
+
+	rawResp, err := r.client.LegacyEditUpgradeProfile(ctx, editParams)
+	resp.Diagnostics.Append(legacyActionDiags("Failed to update upgrade profile", rawResp, err)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(reconcileProfileTargeting(ctx, plan.Tags, state.Tags, plan.AllComputers, state.AllComputers,
+		r.associate(profileName), r.disassociate(profileName))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *UpgradeProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UpgradeProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := r.client.LegacyRemoveUpgradeProfile(ctx, &landscape.LegacyRemoveUpgradeProfileParams{
+		Name: state.Name.ValueString(),
+	})
+	resp.Diagnostics.Append(legacyActionDiags("Failed to remove upgrade profile", rawResp, err)...)
+}
+
+func (r *UpgradeProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+}
+
+func (r *UpgradeProfileResource) associate(name string) profileAssociationFunc {
+	return func(ctx context.Context, tags *[]string, allComputers *bool) (*http.Response, error) {
+		return r.client.LegacyAssociateUpgradeProfile(ctx, &landscape.LegacyAssociateUpgradeProfileParams{
+			Name:         name,
+			Tags:         tags,
+			AllComputers: allComputers,
+		})
+	}
+}
+
+func (r *UpgradeProfileResource) disassociate(name string) profileAssociationFunc {
+	return func(ctx context.Context, tags *[]string, allComputers *bool) (*http.Response, error) {
+		return r.client.LegacyDisassociateUpgradeProfile(ctx, &landscape.LegacyDisassociateUpgradeProfileParams{
+			Name:         name,
+			Tags:         tags,
+			AllComputers: allComputers,
+		})
+	}
+}
+
+// fetchUpgradeProfile looks up an upgrade profile by name. GetUpgradeProfiles
+// cannot filter by name, so every profile is listed.
+func fetchUpgradeProfile(ctx context.Context, client *landscape.ClientWithResponses, name string) (legacyUpgradeProfile, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawResp, err := client.LegacyGetUpgradeProfiles(ctx, &landscape.LegacyGetUpgradeProfilesParams{})
+	if err != nil {
+		diags.AddError("Failed to read upgrade profile", err.Error())
+		return legacyUpgradeProfile{}, false, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode != http.StatusOK {
+		diags.AddError("Failed to read upgrade profile", fmt.Sprintf("status %s: %s", rawResp.Status, body))
+		return legacyUpgradeProfile{}, false, diags
+	}
+
+	profiles, err := landscape.ParseLegacyResponse[[]legacyUpgradeProfile](body)
+	if err != nil {
+		diags.AddError("Failed to parse upgrade profile response", err.Error())
+		return legacyUpgradeProfile{}, false, diags
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, true, diags
+		}
+	}
+	return legacyUpgradeProfile{}, false, diags
+}
+
+func upgradeProfileToState(ctx context.Context, profile legacyUpgradeProfile, state *UpgradeProfileResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tags, d := types.SetValueFrom(ctx, types.StringType, sortedStrings(profile.Tags))
+	diags.Append(d...)
+	state.Tags = tags
+
+	if len(profile.OnDays) > 0 || !state.OnDays.IsNull() {
+		onDays, d := types.SetValueFrom(ctx, types.StringType, sortedStrings(profile.OnDays))
+		diags.Append(d...)
+		state.OnDays = onDays
+	}
+
+	// Landscape keeps an hour on hourly profiles; it only means something
+	// for the other frequencies.
+	if profile.Every == "hour" {
+		state.AtHour = types.Int64Null()
+	} else {
+		state.AtHour = legacyNumberValue(profile.AtHour)
+	}
+	state.AtMinute = legacyNumberValue(profile.AtMinute)
+	if v := legacyNumberValue(profile.DeliverWithin); !v.IsNull() {
+		state.DeliverWithin = v
+	}
+
+	state.Id = types.Int64Value(int64(profile.Id))
+	state.Name = types.StringValue(profile.Name)
+	state.Title = types.StringValue(profile.Title)
+	state.UpgradeType = types.StringValue(profile.UpgradeType)
+	state.Every = types.StringValue(profile.Every)
+	state.Autoremove = types.BoolValue(profile.Autoremove)
+	state.AllComputers = types.BoolValue(profile.AllComputers)
+	if profile.AccessGroup != nil && !state.AccessGroup.IsNull() {
+		state.AccessGroup = types.StringValue(*profile.AccessGroup)
+	}
+	return diags
+}
+
+// legacyNumberValue converts a numeric field of a legacy response, which may
+// be missing or null, to an Int64 value.
+func legacyNumberValue(n json.Number) types.Int64 {
+	v, err := n.Int64()
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(v)
+}
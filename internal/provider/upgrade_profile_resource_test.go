@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUpgradeProfileResourceMetadata(t *testing.T) {
+	res := NewUpgradeProfileResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_upgrade_profile" {
+		t.Fatalf("expected resource type name landscape_upgrade_profile, got %q", resp.TypeName)
+	}
+}
+
+func TestAccUpgradeProfileResourceWeeklyWithoutDays(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUpgradeProfileResourceWeeklyWithoutDaysConfig,
+				ExpectError: regexp.MustCompile(`(?i)on_days`),
+			},
+		},
+	})
+}
+
+const testAccUpgradeProfileResourceWeeklyWithoutDaysConfig = `
+provider "landscape" {}
+
+resource "landscape_upgrade_profile" "test" {
+  title        = "weekly-security"
+  upgrade_type = "security"
+  every        = "week"
+  at_hour      = 3
+  at_minute    = 0
+}
+`
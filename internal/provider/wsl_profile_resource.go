@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	landscape "github.com/jansdhillon/landscape-go-api-client/client"
+)
+
+var _ resource.Resource = &WSLProfileResource{}
+var _ resource.ResourceWithImportState = &WSLProfileResource{}
+var _ resource.ResourceWithIdentity = &WSLProfileResource{}
+
+func NewWSLProfileResource() resource.Resource {
+	return &WSLProfileResource{}
+}
+
+// WSLProfileResource manages WSL (child instance) profiles through the REST
+// API, which the generated client does not cover yet.
+type WSLProfileResource struct {
+	client *landscape.ClientWithResponses
+}
+
+type WSLProfileResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Title           types.String `tfsdk:"title"`
+	Description     types.String `tfsdk:"description"`
+	ImageName       types.String `tfsdk:"image_name"`
+	ImageSource     types.String `tfsdk:"image_source"`
+	CloudInit       types.String `tfsdk:"cloud_init"`
+	CloudInitSha256 types.String `tfsdk:"cloud_init_sha256"`
+	AccessGroup     types.String `tfsdk:"access_group"`
+	AllComputers    types.Bool   `tfsdk:"all_computers"`
+	Tags            types.Set    `tfsdk:"tags"`
+}
+
+// wslProfile is the REST representation of a WSL profile. The cloud-init
+// user data travels base64-encoded.
+type wslProfile struct {
+	Name              string   `json:"name,omitempty"`
+	Title             string   `json:"title"`
+	Description       string   `json:"description"`
+	ImageName         string   `json:"image_name,omitempty"`
+	ImageSource       *string  `json:"image_source,omitempty"`
+	CloudInitContents *string  `json:"cloud_init_contents,omitempty"`
+	AccessGroup       *string  `json:"access_group,omitempty"`
+	AllComputers      bool     `json:"all_computers"`
+	Tags              []string `json:"tags"`
+}
+
+func (r *WSLProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wsl_profile"
+}
+
+func (r *WSLProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		MarkdownDescription: "Manages a Landscape WSL profile, which creates Windows Subsystem for Linux instances on the Windows computers it targets.",
+		Attributes: map[string]resourceschema.Attribute{
+			"name": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The slug name of the profile returned by the API.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Title for the WSL profile.",
+			},
+			"description": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the profile.",
+			},
+			"image_name": resourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the WSL image to install, e.g. `Ubuntu-24.04`. Changing this forces a new profile.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"image_source": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of a custom root filesystem to install instead of the image from the Microsoft Store. Changing this forces a new profile.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"cloud_init": resourceschema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "cloud-init user data applied when the instance is created. Must not be empty; omit it instead. Changing this forces a new profile.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"cloud_init_sha256": resourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hex-encoded SHA-256 digest of `cloud_init`, for comparing user data without revealing it.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"access_group": resourceschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Access group to create the profile in.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"all_computers": resourceschema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to apply the profile to all computers.",
+			},
+			"tags": resourceschema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Tags used to target computers.",
+			},
+		},
+	}
+}
+
+func (r *WSLProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("Name of the WSL profile.")
+}
+
+func (r *WSLProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*landscape.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *landscape.ClientWithResponses, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *WSLProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WSLProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := planToWSLProfile(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body.ImageName = plan.ImageName.ValueString()
+	body.ImageSource = plan.ImageSource.ValueStringPointer()
+	body.AccessGroup = plan.AccessGroup.ValueStringPointer()
+	if !plan.CloudInit.IsNull() {
+		encoded := base64.StdEncoding.EncodeToString([]byte(plan.CloudInit.ValueString()))
+		body.CloudInitContents = &encoded
+	}
+
+	profile, _, diags := r.send(ctx, http.MethodPost, "/api/child-instance-profiles", body, "Failed to create WSL profile")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if profile.Name == "" {
+		resp.Diagnostics.AddError("Failed to parse WSL profile response", "response missing 'name' field")
+		return
+	}
+
+	plan.Name = types.StringValue(profile.Name)
+	plan.CloudInitSha256 = types.StringNull()
+	if !plan.CloudInit.IsNull() {
+		plan.CloudInitSha256 = types.StringValue(sha256Hex([]byte(plan.CloudInit.ValueString())))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *WSLProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WSLProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, found, diags := r.send(ctx, http.MethodGet, wslProfileEndpoint(state.Name.ValueString()), nil, "Failed to read WSL profile")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, sortedStrings(profile.Tags))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Name = types.StringValue(profile.Name)
+	state.Title = types.StringValue(profile.Title)
+	if profile.Description != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(profile.Description)
+	}
+	state.ImageName = types.StringValue(profile.ImageName)
+	if profile.ImageSource != nil && *profile.ImageSource != "" {
+		state.ImageSource = types.StringValue(*profile.ImageSource)
+	} else {
+		state.ImageSource = types.StringNull()
+	}
+	if profile.AccessGroup != nil && !state.AccessGroup.IsNull() {
+		state.AccessGroup = types.StringValue(*profile.AccessGroup)
+	}
+	state.AllComputers = types.BoolValue(profile.AllComputers)
+	state.Tags = tags
+
+	// Only replace the user data when its hash changed, so the sensitive
+	// value is not rewritten on every refresh.
+	if profile.CloudInitContents != nil {
+		cloudInit, err := base64.StdEncoding.DecodeString(*profile.CloudInitContents)
+		if err != nil {
+			cloudInit = []byte(*profile.CloudInitContents)
+		}
+		switch sum := sha256Hex(cloudInit); {
+		case len(cloudInit) == 0:
+			state.CloudInit = types.StringNull()
+			state.CloudInitSha256 = types.StringNull()
+		case sum != state.CloudInitSha256.ValueString():
+			state.CloudInit = types.StringValue(string(cloudInit))
+			state.CloudInitSha256 = types.StringValue(sum)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: state.Name})...)
+}
+
+func (r *WSLProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state WSLProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := planToWSLProfile(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, diags = r.send(ctx, http.MethodPatch, wslProfileEndpoint(state.Name.ValueString()), body, "Failed to update WSL profile")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Name = state.Name
+	plan.CloudInitSha256 = state.CloudInitSha256
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, nameIdentityModel{Name: plan.Name})...)
+}
+
+func (r *WSLProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WSLProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawResp, err := doRESTRequest(ctx, r.client, http.MethodDelete, wslProfileEndpoint(state.Name.ValueString()), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to remove WSL profile", err.Error())
+		return
+	}
+	defer rawResp.Body.Close()
+	if rawResp.StatusCode >= 300 && rawResp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(rawResp.Body)
+		resp.Diagnostics.AddError("Failed to remove WSL profile", restStatusError(rawResp, body))
+	}
+}
+
+func (r *WSLProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+}
+
+// send makes a REST call that returns a WSL profile. found is false when the
+// profile does not exist.
+func (r *WSLProfileResource) send(ctx context.Context, method, endpoint string, reqBody any, summary string) (wslProfile, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var profile wslProfile
+
+	rawResp, err := doRESTRequest(ctx, r.client, method, endpoint, nil, reqBody)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return profile, false, diags
+	}
+	defer rawResp.Body.Close()
+	body, _ := io.ReadAll(rawResp.Body)
+	if rawResp.StatusCode == http.StatusNotFound {
+		return profile, false, diags
+	}
+	if rawResp.StatusCode < 200 || rawResp.StatusCode >= 300 {
+		diags.AddError(summary, restStatusError(rawResp, body))
+		return profile, false, diags
+	}
+
+	if err := json.Unmarshal(body, &profile); err != nil {
+		diags.AddError("Failed to parse WSL profile response", err.Error())
+		return profile, false, diags
+	}
+	return profile, true, diags
+}
+
+func wslProfileEndpoint(name string) string {
+	return "/api/child-instance-profiles/" + url.PathEscape(name)
+}
+
+// planToWSLProfile builds the attributes that can be changed in place. The
+// image, user data and access group are only sent on create.
+func planToWSLProfile(ctx context.Context, plan WSLProfileResourceModel) (wslProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags := []string{}
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
+		diags.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	return wslProfile{
+		Title:        plan.Title.ValueString(),
+		Description:  plan.Description.ValueString(),
+		AllComputers: plan.AllComputers.ValueBool(),
+		Tags:         tags,
+	}, diags
+}
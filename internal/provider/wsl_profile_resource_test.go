@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	pfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestWSLProfileResourceMetadata(t *testing.T) {
+	res := NewWSLProfileResource()
+
+	var resp pfresource.MetadataResponse
+	res.Metadata(context.Background(), pfresource.MetadataRequest{ProviderTypeName: "landscape"}, &resp)
+
+	if resp.TypeName != "landscape_wsl_profile" {
+		t.Fatalf("expected resource type name landscape_wsl_profile, got %q", resp.TypeName)
+	}
+}
+
+func TestWSLProfileResourceRejectsEmptyCloudInit(t *testing.T) {
+	var schemaResp pfresource.SchemaResponse
+	NewWSLProfileResource().Schema(context.Background(), pfresource.SchemaRequest{}, &schemaResp)
+
+	cloudInit, ok := schemaResp.Schema.Attributes["cloud_init"].(resourceschema.StringAttribute)
+	if !ok {
+		t.Fatal("expected a cloud_init string attribute")
+	}
+	for value, wantErr := range map[string]bool{"": true, "#cloud-config\n": false} {
+		var diags diag.Diagnostics
+		for _, v := range cloudInit.Validators {
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("cloud_init"),
+				ConfigValue: types.StringValue(value),
+			}, resp)
+			diags.Append(resp.Diagnostics...)
+		}
+		if diags.HasError() != wantErr {
+			t.Errorf("cloud_init %q: expected error %t, got %v", value, wantErr, diags)
+		}
+	}
+}
+
+func TestAccWSLProfileResourceMissingImage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWSLProfileResourceMissingImageConfig,
+				ExpectError: regexp.MustCompile(`(?i)image_name`),
+			},
+		},
+	})
+}
+
+const testAccWSLProfileResourceMissingImageConfig = `
+provider "landscape" {}
+
+resource "landscape_wsl_profile" "test" {
+  title      = "dev-workstation"
+  cloud_init = "#cloud-config\npackages: [git]\n"
+}
+`